@@ -2,12 +2,14 @@ package bconf
 
 import (
 	"fmt"
+	"log/slog"
+	"maps"
 	"os"
 	"reflect"
 	"slices"
-	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -19,10 +21,15 @@ func NewAppConfig(appName, appDescription string, options ...ConfigOption) *AppC
 
 	appVersion := "unknown"
 	appID := "undefined"
+	appProfile := ""
 
 	var (
 		appIDGenerator      func() (any, error)
 		appVersionGenerator func() (any, error)
+		loaderPrecedence    []string
+		helpOptions         HelpOptions
+		logger              *slog.Logger
+		hooks               hooks
 	)
 
 	for _, option := range options {
@@ -73,11 +80,51 @@ func NewAppConfig(appName, appDescription string, options ...ConfigOption) *AppC
 			} else {
 				warnings = append(warnings, "problem casting app version func option")
 			}
+		case configOptionTypeLoaderPrecedence:
+			if castOption, ok := option.(configOptionLoaderPrecedence); ok {
+				loaderPrecedence = castOption.loaderNames
+			} else {
+				warnings = append(warnings, "problem casting loader precedence option")
+			}
+		case configOptionTypeHelpOptions:
+			if castOption, ok := option.(configOptionHelpOptions); ok {
+				helpOptions = castOption.options
+			} else {
+				warnings = append(warnings, "problem casting help options option")
+			}
+		case configOptionTypeAppProfile:
+			if castOption, ok := option.(configOptionAppProfile); ok {
+				appProfile = castOption.profile
+			} else {
+				warnings = append(warnings, "problem casting app profile option")
+			}
+		case configOptionTypeLogger:
+			if castOption, ok := option.(configOptionLogger); ok {
+				logger = castOption.logger
+			} else {
+				warnings = append(warnings, "problem casting logger option")
+			}
+		case configOptionTypeHooks:
+			if castOption, ok := option.(configOptionHooks); ok {
+				hooks = append(hooks, castOption.hooks)
+			} else {
+				warnings = append(warnings, "problem casting hooks option")
+			}
 		default:
 			warnings = append(warnings, fmt.Sprintf("unsupported config option '%s'", option.ConfigOptionType()))
 		}
 	}
 
+	if len(loaderPrecedence) > 0 {
+		var unknownLoaders []string
+
+		loaders, unknownLoaders = orderLoaders(loaders, loaderPrecedence)
+
+		for _, loaderName := range unknownLoaders {
+			warnings = append(warnings, fmt.Sprintf("loader precedence references unknown loader '%s'", loaderName))
+		}
+	}
+
 	var (
 		appIDField      *Field
 		appVersionField *Field
@@ -95,22 +142,39 @@ func NewAppConfig(appName, appDescription string, options ...ConfigOption) *AppC
 		appIDField = FB("id", String).Default(appID).C()
 	}
 
+	appProfileField := FB("profile", String).Description(
+		"Application profile (e.g. 'dev', 'staging', 'prod') used to select profile-specific configuration",
+	)
+
+	if appProfile != "" {
+		appProfileField.Default(appProfile)
+	}
+
 	appFieldSet := FSB("app").Fields(
 		FB("name", String).Default(appName).C(),
 		FB("description", String).Default(appDescription).C(),
 		appVersionField,
 		appIDField,
+		appProfileField.C(),
 	).C()
 
 	config := &AppConfig{
 		fieldSetGroups:   fieldSetGroups{},
 		fieldSets:        map[string]*FieldSet{},
+		fieldSetCommands: map[string]string{},
 		fillStructs:      []any{},
+		helpOptions:      helpOptions,
+		hooks:            hooks,
 		loaders:          loaders,
-		warnings:         warnings,
+		logger:           logger,
+		warnings:         []string{},
 		orderedFieldSets: FieldSets{},
 	}
 
+	for _, warning := range warnings {
+		config.warn(warning)
+	}
+
 	config.AddFieldSet(appFieldSet)
 
 	return config
@@ -120,68 +184,150 @@ func NewAppConfig(appName, appDescription string, options ...ConfigOption) *AppC
 
 // AppConfig manages application configuration field-sets and provides access to configuration values. It should be
 // initialized with the NewAppConfig function.
+//
+// Added field-sets, field-set groups, and commands are registered when the AppConfig is loaded, so methods describing
+// the configuration (e.g. FieldSets, JSONSchema, Docs, and CompletionScript) include them once Load has been called.
+//
+// AppConfig is safe for concurrent use. Getters, FillStruct, ConfigMap, and HelpString share a read lock, while Load,
+// the reload methods, SetField, and the field-set registration methods are serialized and only take an exclusive lock
+// to swap in their changes, so readers never observe a partially loaded or partially set configuration. Fields
+// returned by GetField must be treated as read-only. For lock-free reads of a consistent configuration generation, use
+// Snapshot.
+//
+// Load conditions, validators, default generators, transformers, and hooks run without holding the lock, so they may
+// read the AppConfig, observing the values from before the load, reload, or set that is running them. They must not
+// call Load, the reload methods, SetField, or the registration methods, which wait for the running change to finish.
 type AppConfig struct {
 	fieldSets        map[string]*FieldSet
+	fieldSetCommands map[string]string
 	fieldSetGroups   fieldSetGroups
+	commands         []*Command
 	loaders          []Loader
 	fillStructs      []any
 	warnings         []string
+	logger           *slog.Logger
+	hooks            hooks
 	orderedFieldSets FieldSets
-	fieldSetLock     sync.Mutex
+	snapshot         atomic.Pointer[ConfigSnapshot]
+	lock             sync.RWMutex
+	changeLock       sync.Mutex
+	selectedCommand  string
+	helpOptions      HelpOptions
+	generation       uint64
 	loaded           bool
 }
 
 func (c *AppConfig) AppName() string {
-	name, _ := c.GetString("app", "name")
+	c.lock.RLock()
+	defer c.lock.RUnlock()
 
-	return name
+	return c.appValue("name")
 }
 
 func (c *AppConfig) AppDescription() string {
-	description, _ := c.GetString("app", "description")
+	c.lock.RLock()
+	defer c.lock.RUnlock()
 
-	return description
+	return c.appValue("description")
 }
 
 func (c *AppConfig) AppVersion() string {
-	version, _ := c.GetString("app", "version")
+	c.lock.RLock()
+	defer c.lock.RUnlock()
 
-	return version
+	return c.appValue("version")
 }
 
 func (c *AppConfig) AppID() string {
-	id, _ := c.GetString("app", "id")
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.appValue("id")
+}
+
+// AppProfile returns the active application profile, or an empty string when no profile is active.
+func (c *AppConfig) AppProfile() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
 
-	return id
+	return c.appValue("profile")
 }
 
 func (c *AppConfig) AddFieldSetGroup(groupName string, fieldSets FieldSets) {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	c.fieldSetGroups = append(c.fieldSetGroups, &fieldSetGroup{name: groupName, fieldSets: fieldSets})
 }
 
+// AddFieldSetGroups registers field-set groups, which carry a description, an order, and load conditions applying to
+// every field-set in the group.
+func (c *AppConfig) AddFieldSetGroups(groups ...*FieldSetGroup) {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, group := range groups {
+		c.fieldSetGroups = append(c.fieldSetGroups, newFieldSetGroup(group, ""))
+	}
+}
+
+// FieldSetGroups returns descriptions of the registered field-set groups, ordered by group order. Field-sets added
+// with AddFieldSet are described as single field-set groups named after the field-set key.
+func (c *AppConfig) FieldSetGroups() []FieldSetGroupInfo {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	groups := c.fieldSetGroups.sorted()
+	infos := make([]FieldSetGroupInfo, len(groups))
+
+	for idx, group := range groups {
+		infos[idx] = group.info()
+	}
+
+	return infos
+}
+
 func (c *AppConfig) AttachConfigStructs(configStructs ...any) {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
 	c.fillStructs = append(c.fillStructs, configStructs...)
 }
 
 func (c *AppConfig) AddFieldSet(fieldSet *FieldSet) {
-	c.fieldSetGroups = append(c.fieldSetGroups, &fieldSetGroup{name: fieldSet.Key, fieldSets: FieldSets{fieldSet}})
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.fieldSetGroups = append(c.fieldSetGroups, &fieldSetGroup{
+		name:      fieldSet.Key,
+		fieldSets: FieldSets{fieldSet},
+		implicit:  true,
+	})
 }
 
 func (c *AppConfig) GetField(fieldSetKey, fieldKey string) (*Field, error) {
-	fieldSet, found := c.fieldSets[fieldSetKey]
-	if !found {
-		return nil, fmt.Errorf("field-set not found with key '%s'", fieldSetKey)
-	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
 
-	field, found := fieldSet.fieldMap[fieldKey]
-	if !found {
-		return nil, fmt.Errorf("field-set field not found with key '%s'", fieldKey)
-	}
-
-	return field, nil
+	return c.findField(fieldSetKey, fieldKey)
 }
 
 func (c *AppConfig) SetField(fieldSetKey, fieldKey string, fieldValue any) error {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
 	fieldSet, fieldSetFound := c.fieldSets[fieldSetKey]
 	if !fieldSetFound {
 		return fmt.Errorf("field-set with key '%s' not found", fieldSetKey)
@@ -192,13 +338,53 @@ func (c *AppConfig) SetField(fieldSetKey, fieldKey string, fieldValue any) error
 		return fmt.Errorf("field with key '%s' not found", fieldKey)
 	}
 
-	if err := field.setOverride(fieldValue); err != nil {
+	overrideValue, err := field.checkOverrideValue(fieldValue)
+	if err != nil {
+		return fmt.Errorf("problem setting field value: %w", err)
+	}
+
+	c.setOverride(fieldSetKey, field, overrideValue)
+
+	return nil
+}
+
+// SetFieldString parses a string value using the field type (e.g. '30s' for a duration field, or 'a,b' for a list
+// field) and sets it as the field value, as SetField does.
+func (c *AppConfig) SetFieldString(fieldSetKey, fieldKey, fieldValue string) error {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
+	field, err := c.findField(fieldSetKey, fieldKey)
+	if err != nil {
+		return err
+	}
+
+	parsedValue, err := field.parseString(fieldValue)
+	if err != nil {
+		return fmt.Errorf("problem parsing field value: %w", err)
+	}
+
+	overrideValue, err := field.checkOverrideValue(parsedValue)
+	if err != nil {
 		return fmt.Errorf("problem setting field value: %w", err)
 	}
 
+	c.setOverride(fieldSetKey, field, overrideValue)
+
 	return nil
 }
 
+// Snapshot returns an immutable view of the most recently loaded configuration values. Snapshots are safe for
+// concurrent use, and a new snapshot is atomically swapped in whenever the configuration is loaded or a field value is
+// set, so readers always see a consistent generation of values. Before Load is called the snapshot is empty.
+func (c *AppConfig) Snapshot() *ConfigSnapshot {
+	if snapshot := c.snapshot.Load(); snapshot != nil {
+		return snapshot
+	}
+
+	return newConfigSnapshot(nil, 0)
+}
+
 func (c *AppConfig) GetString(fieldSetKey, fieldKey string) (string, error) {
 	fieldValue, err := c.getFieldValue(fieldSetKey, fieldKey, String)
 	if err != nil {
@@ -309,47 +495,205 @@ func (c *AppConfig) GetDurations(fieldSetKey, fieldKey string) ([]time.Duration,
 	return val, nil
 }
 
+// Load adds registered field-sets, loads field values from the configured loaders, and fills attached config structs.
+// When loading fails, previously loaded field values are kept. When the application is run with the reserved
+// '--check-config' flag, Load prints a configuration check report and exits the process, with a non-zero status when
+// loading failed.
 func (c *AppConfig) Load(options ...LoadOption) []error {
+	errs := c.load(options...)
+
+	if c.checkConfigRequested(options) {
+		report, failed := c.checkConfigReport(errs)
+
+		fmt.Print(report)
+
+		if failed {
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
+	return errs
+}
+
+func (c *AppConfig) load(options ...LoadOption) []error {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
+	staged := c.stage()
+
+	errs := staged.loadStaged(options...)
+	if len(errs) > 0 {
+		staged.rollback(c)
+	}
+
+	c.commit(staged)
+
+	return errs
+}
+
+// rollback restores the field values of a staged configuration that failed to load from the configuration it was
+// staged from. Field-set groups registered by the load are kept, where newly added field-sets have no loader values.
+func (c *AppConfig) rollback(previous *AppConfig) {
+	for idx, fieldSet := range c.orderedFieldSets {
+		if previousFieldSet, found := previous.fieldSets[fieldSet.Key]; found {
+			fieldSet = previousFieldSet.Clone()
+		} else {
+			for _, field := range fieldSet.fieldMap {
+				field.resetLoaderValues()
+			}
+		}
+
+		c.orderedFieldSets[idx] = fieldSet
+		c.fieldSets[fieldSet.Key] = fieldSet
+	}
+
+	c.selectedCommand = previous.selectedCommand
+
+	c.activateProfile()
+
+	if !c.loaded {
+		return
+	}
+
+	for _, fillStruct := range c.fillStructs {
+		_ = c.fillAttachedStruct(fillStruct)
+	}
+}
+
+// loadStaged loads a configuration staged by the stage method, which is not shared with readers.
+func (c *AppConfig) loadStaged(options ...LoadOption) (loadErrs []error) {
+	defer func() {
+		c.logLoad(loadErrs)
+	}()
+
 	// -- Add field set groups --
 	groupAddErrors := []error{}
 
 	for _, group := range c.fieldSetGroups {
-		if errs := c.addFieldSets(group.fieldSets...); len(errs) > 0 {
+		if group.added {
+			continue
+		}
+
+		if errs := c.addFieldSets(group.groupFieldSets()...); len(errs) > 0 {
 			err := fmt.Errorf("problem(s) adding '%s' field-set group: %v", group.name, errs)
 
 			groupAddErrors = append(groupAddErrors, err)
+
+			continue
 		}
+
+		for _, fieldSet := range group.fieldSets {
+			c.fieldSetCommands[fieldSet.Key] = group.command
+		}
+
+		group.added = true
 	}
 
 	if len(groupAddErrors) > 0 {
 		return groupAddErrors
 	}
 
+	if errs := c.registerLoaderFields(); len(errs) > 0 {
+		return errs
+	}
+
+	// -- Select command --
+
+	args := c.argsFlagLoader().args()
+
+	c.selectedCommand = c.selectCommand()
+
+	for _, loader := range c.loaders {
+		if commandLoader, ok := loader.(CommandLoader); ok {
+			commandLoader.SetCommand(c.selectedCommand)
+		}
+	}
+
+	defer c.prepareLoaders()()
+
 	// -- Parse load options --
 
 	handleHelpFlag := true
+	handleGenerateFlag := true
+	strictMode := false
 
 	for _, option := range options {
 		switch option.LoadOptionType() {
 		case loadOptionTypeDisableHelpFlag:
 			handleHelpFlag = false
+		case loadOptionTypeDisableGenerateFlag:
+			handleGenerateFlag = false
+		case loadOptionTypeDisableCheckConfigFlag:
+			// handled by Load
+		case loadOptionTypeStrictMode:
+			strictMode = true
 		default:
-			c.warnings = append(c.warnings, fmt.Sprintf("unsupported load option '%s'", option.LoadOptionType()))
+			c.warn(fmt.Sprintf("unsupported load option '%s'", option.LoadOptionType()))
 		}
 	}
 
+	// -- Load app field-set and activate the app profile --
+
+	appLoadErrors := c.loadFieldSet("app")
+
+	c.activateProfile()
+
 	// -- Output help message if conditions are satisfied --
 
-	if handleHelpFlag && len(os.Args) > 1 && (os.Args[1] == "--help" || os.Args[1] == "-h") {
+	if handleHelpFlag && helpRequested(args, c.selectedCommand) {
 		c.printHelpString()
 		os.Exit(0)
 	}
 
+	// -- Output completion script if conditions are satisfied --
+
+	if shell, found := generateFlagValue(args, generateCompletionFlag); handleGenerateFlag && found {
+		script, err := c.completionScript(shell)
+		if err != nil {
+			return []error{err}
+		}
+
+		fmt.Print(script)
+		os.Exit(0)
+	}
+
+	// -- Output reference documentation if conditions are satisfied --
+
+	if format, found := generateFlagValue(args, generateDocsFlag); handleGenerateFlag && found {
+		docs, err := c.docs(format)
+		if err != nil {
+			return []error{err}
+		}
+
+		fmt.Print(docs)
+		os.Exit(0)
+	}
+
+	if loaderErrors := c.loaderErrors(); len(loaderErrors) > 0 {
+		return loaderErrors
+	}
+
+	if strictMode {
+		if unknownKeyErrors := c.unknownKeyErrors(); len(unknownKeyErrors) > 0 {
+			return unknownKeyErrors
+		}
+	}
+
+	if len(appLoadErrors) > 0 {
+		return appLoadErrors
+	}
+
 	// -- Load field-sets --
 
 	loadErrors := []error{}
 
 	for _, fieldSet := range c.orderedFieldSets {
+		if fieldSet.Key == "app" || !c.fieldSetInCommandScope(fieldSet.Key, c.selectedCommand) {
+			continue
+		}
+
 		if fieldSetErrs := c.loadFieldSet(fieldSet.Key); len(fieldSetErrs) > 0 {
 			loadErrors = append(loadErrors, fieldSetErrs...)
 			return loadErrors
@@ -359,7 +703,7 @@ func (c *AppConfig) Load(options ...LoadOption) []error {
 	fillErrors := []error{}
 
 	for _, fillStruct := range c.fillStructs {
-		if fillErr := c.FillStruct(fillStruct); fillErr != nil {
+		if fillErr := c.fillAttachedStruct(fillStruct); fillErr != nil {
 			fillErrors = append(fillErrors, fillErr)
 		}
 	}
@@ -370,10 +714,99 @@ func (c *AppConfig) Load(options ...LoadOption) []error {
 
 	c.loaded = true
 
+	c.publishSnapshot()
+
 	return nil
 }
 
-func (c *AppConfig) FillStruct(configStruct any) (err error) {
+func (c *AppConfig) FillStruct(configStruct any) error {
+	c.lock.RLock()
+	err := c.fillStruct(configStruct)
+	c.lock.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return c.hooks.afterFillStruct(configStruct)
+}
+
+// ConfigMap returns a map of field values with sensitive values masked and durations converted to '<key>_ms'
+// millisecond values. The app name and description are omitted. Export provides configurable formats, masking, and
+// duration formatting.
+func (c *AppConfig) ConfigMap() map[string]map[string]any {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	configMap := map[string]map[string]any{}
+
+	for _, fieldSet := range c.fieldSets {
+		fieldSetMap := map[string]any{}
+
+		for _, field := range fieldSet.fieldMap {
+			location := fmt.Sprintf("%s.%s", fieldSet.Key, field.Key)
+
+			switch location {
+			case "app.name":
+			case "app.description":
+				continue
+			}
+
+			val, err := field.getValue()
+
+			if err != nil {
+				continue
+			}
+
+			if field.Sensitive {
+				fieldSetMap[field.Key] = "<sensitive-value>"
+				continue
+			}
+
+			if field.Type == Duration {
+				// TODO: use higher time grain if duration > 1 hour ?
+				valDuration, _ := val.(time.Duration)
+				valMilliseconds := valDuration.Milliseconds()
+				fieldSetMap[fmt.Sprintf("%s_ms", field.Key)] = valMilliseconds
+
+				continue
+			}
+
+			fieldSetMap[field.Key] = val
+		}
+
+		configMap[fieldSet.Key] = fieldSetMap
+	}
+
+	return configMap
+}
+
+func (c *AppConfig) Warnings() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return slices.Clone(c.warnings)
+}
+
+func (c *AppConfig) HelpString() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.helpString(c.selectedCommand)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// fillAttachedStruct fills a config struct, running the configured after fill struct hooks.
+func (c *AppConfig) fillAttachedStruct(configStruct any) error {
+	if err := c.fillStruct(configStruct); err != nil {
+		return err
+	}
+
+	return c.hooks.afterFillStruct(configStruct)
+}
+
+func (c *AppConfig) fillStruct(configStruct any) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = fmt.Errorf("problem filling struct: %s", r)
@@ -429,7 +862,7 @@ func (c *AppConfig) FillStruct(configStruct any) (err error) {
 				configStructValue.Field(i).Set(reflect.New(field.Type.Elem()))
 			}
 
-			if err := c.FillStruct(configStructValue.Field(i).Interface()); err != nil {
+			if err := c.fillStruct(configStructValue.Field(i).Interface()); err != nil {
 				return fmt.Errorf("problem filling struct field: %w", err)
 			}
 
@@ -462,7 +895,7 @@ func (c *AppConfig) FillStruct(configStruct any) (err error) {
 			return fmt.Errorf("unidentified field-set for field: %s", fieldKey)
 		}
 
-		appConfigField, err := c.GetField(fieldSetKey, fieldKey)
+		appConfigField, err := c.findField(fieldSetKey, fieldKey)
 		if err != nil {
 			return fmt.Errorf("problem getting field '%s.%s': %w", fieldSetKey, fieldKey, err)
 		}
@@ -480,89 +913,12 @@ func (c *AppConfig) FillStruct(configStruct any) (err error) {
 	return nil
 }
 
-func (c *AppConfig) ConfigMap() map[string]map[string]any {
-	configMap := map[string]map[string]any{}
-
-	for _, fieldSet := range c.fieldSets {
-		fieldSetMap := map[string]any{}
-
-		for _, field := range fieldSet.fieldMap {
-			location := fmt.Sprintf("%s.%s", fieldSet.Key, field.Key)
-
-			switch location {
-			case "app.name":
-			case "app.description":
-				continue
-			}
-
-			val, err := field.getValue()
-
-			if err != nil {
-				continue
-			}
-
-			if field.Sensitive {
-				fieldSetMap[field.Key] = "<sensitive-value>"
-				continue
-			}
-
-			if field.Type == Duration {
-				// TODO: use higher time grain if duration > 1 hour ?
-				valDuration, _ := val.(time.Duration)
-				valMilliseconds := valDuration.Milliseconds()
-				fieldSetMap[fmt.Sprintf("%s_ms", field.Key)] = valMilliseconds
-
-				continue
-			}
-
-			fieldSetMap[field.Key] = val
-		}
-
-		configMap[fieldSet.Key] = fieldSetMap
-	}
-
-	return configMap
-}
-
-func (c *AppConfig) Warnings() []string {
-	return slices.Clone(c.warnings)
-}
-
-func (c *AppConfig) HelpString() string {
-	maxCharLength := 100
-	builder := strings.Builder{}
-
-	name := c.AppName()
-	description := c.AppDescription()
-
-	if name != "" {
-		builder.WriteString(fmt.Sprintf("Usage of '%s':\n", name))
-	} else {
-		builder.WriteString(fmt.Sprintf("Usage of '%s':\n", os.Args[0]))
-	}
-
-	if description != "" && len(description) > maxCharLength {
-		wrapStringForBuilder(description, &builder, maxCharLength, "")
-	} else if description != "" {
-		builder.WriteString(fmt.Sprintf("%s\n\n", description))
-	}
-
-	c.addFieldsToBuilder(&builder, maxCharLength)
-
-	return builder.String()
-}
-
-// --------------------------------------------------------------------------------------------------------------------
-
 func (c *AppConfig) addFieldSets(fieldSets ...*FieldSet) []error {
-	c.fieldSetLock.Lock()
-	defer c.fieldSetLock.Unlock()
-
 	errs := []error{}
 	addedFieldSets := []string{}
 
 	for _, fieldSet := range fieldSets {
-		if fieldSetErrs := c.addFieldSet(fieldSet, false); len(fieldSetErrs) > 0 {
+		if fieldSetErrs := c.addFieldSet(fieldSet); len(fieldSetErrs) > 0 {
 			errs = append(errs, fieldSetErrs...)
 			continue
 		}
@@ -581,12 +937,7 @@ func (c *AppConfig) addFieldSets(fieldSets ...*FieldSet) []error {
 	return errs
 }
 
-func (c *AppConfig) addFieldSet(fieldSet *FieldSet, lock bool) []error {
-	if lock {
-		c.fieldSetLock.Lock()
-		defer c.fieldSetLock.Unlock()
-	}
-
+func (c *AppConfig) addFieldSet(fieldSet *FieldSet) []error {
 	fieldSet = fieldSet.Clone()
 
 	if errs := c.checkForFieldSetStructuralIntegrity(fieldSet); len(errs) > 0 {
@@ -616,6 +967,14 @@ func (c *AppConfig) addFieldSet(fieldSet *FieldSet, lock bool) []error {
 	c.fieldSets[fieldSet.Key] = fieldSet
 	c.orderedFieldSets = append(c.orderedFieldSets, fieldSet)
 
+	if len(fieldSet.LoaderPrecedence) > 0 {
+		_, unknownLoaders := orderLoaders(c.loaders, fieldSet.LoaderPrecedence)
+
+		for _, loaderName := range unknownLoaders {
+			c.warn(fmt.Sprintf("field-set '%s' loader precedence references unknown loader '%s'", fieldSet.Key, loaderName))
+		}
+	}
+
 	return nil
 }
 
@@ -745,7 +1104,9 @@ func (c *AppConfig) checkForFieldSetFieldsValidity(fieldSet *FieldSet) []error {
 	return errs
 }
 
-func (c *AppConfig) loadFieldSet(fieldSetKey string) []error {
+// loadFieldSet loads field values from the config loaders, resetting previously loaded values first. When field keys
+// are provided only the matching fields are loaded, otherwise all fields in the field-set are loaded.
+func (c *AppConfig) loadFieldSet(fieldSetKey string, fieldKeys ...string) []error {
 	errs := []error{}
 
 	fieldSet, fieldSetFound := c.fieldSets[fieldSetKey]
@@ -754,14 +1115,28 @@ func (c *AppConfig) loadFieldSet(fieldSetKey string) []error {
 		return errs
 	}
 
+	if len(fieldKeys) < 1 {
+		fieldKeys = fieldSet.fieldKeys()
+	}
+
+	for _, fieldKey := range fieldKeys {
+		if field, found := fieldSet.fieldMap[fieldKey]; found {
+			field.resetLoaderValues()
+		}
+	}
+
 	if load, err := c.shouldLoadFieldSet(fieldSet); err != nil {
 		return append(errs, err)
 	} else if !load {
 		return errs
 	}
 
-	for _, loader := range c.loaders {
-		values := loader.GetMap(fieldSetKey, c.fieldSets[fieldSetKey].fieldKeys())
+	if err := c.hooks.beforeFieldSetLoad(fieldSetKey); err != nil {
+		return append(errs, err)
+	}
+
+	for _, loader := range c.fieldSetLoaders(fieldSet) {
+		values := loaderValues(loader, fieldSetKey, fieldKeys)
 		for key, value := range values {
 			field := c.fieldSets[fieldSetKey].fieldMap[key]
 
@@ -772,13 +1147,28 @@ func (c *AppConfig) loadFieldSet(fieldSetKey string) []error {
 				continue
 			}
 
-			if err := c.fieldSets[fieldSetKey].fieldMap[key].set(loader.Name(), value); err != nil {
+			if !field.loaderAllowed(loader.Name()) {
+				errs = append(errs, fmt.Errorf(
+					"field '%s' load error: value from loader '%s' is not permitted", key, loader.Name(),
+				))
+
+				continue
+			}
+
+			if err := c.setLoaderValue(fieldSetKey, field, loader.Name(), value); err != nil {
 				errs = append(errs, fmt.Errorf("field '%s' load error: %w", key, err))
+			} else if field.Deprecated != "" {
+				c.warnDeprecated(fieldSetKey, field, loader.Name())
 			}
 		}
 	}
 
-	for _, field := range fieldSet.fieldMap {
+	for _, fieldKey := range fieldKeys {
+		field, found := fieldSet.fieldMap[fieldKey]
+		if !found {
+			continue
+		}
+
 		if field.Required && len(field.LoadConditions) < 1 {
 			if _, err := field.getValue(); err != nil {
 				errs = append(errs, fmt.Errorf("required field '%s_%s' not set", fieldSet.Key, field.Key))
@@ -796,9 +1186,257 @@ func (c *AppConfig) loadFieldSet(fieldSetKey string) []error {
 		}
 	}
 
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if err := c.hooks.afterFieldSetLoad(fieldSetKey); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errs
 }
 
+// setLoaderValue transforms, parses, and validates a loader value, running the configured hooks before the value is
+// set on the field. Typed values that are not strings are converted to the field-type without the value transform
+// hook.
+func (c *AppConfig) setLoaderValue(fieldSetKey string, field *Field, loaderName string, value any) error {
+	var (
+		parsedValue any
+		err         error
+	)
+
+	value, err = c.hooks.transformTypedValue(fieldSetKey, field.Key, loaderName, jsonTextValue(value, false))
+	if err != nil {
+		return err
+	}
+
+	if stringValue, isString := value.(string); isString {
+		parsedValue, err = field.parseLoaderValue(stringValue)
+	} else {
+		parsedValue, err = field.convertLoaderValue(value)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := c.hooks.afterValidation(fieldSetKey, field.Key, loaderName, parsedValue); err != nil {
+		return err
+	}
+
+	field.set(loaderName, parsedValue)
+
+	return nil
+}
+
+// registerLoaderFields provides field metadata to loaders implementing the FieldAwareLoader interface, checking that
+// field flag shorthands are unique. Fields of different commands may share a shorthand, as only the flags of the
+// selected command are parsed.
+func (c *AppConfig) registerLoaderFields() []error {
+	errs := []error{}
+	loaderFields := []LoaderField{}
+	// shorthands maps a shorthand to the fields using it, keyed by command
+	shorthands := map[string]map[string]string{}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		command := c.fieldSetCommands[fieldSet.Key]
+
+		for _, fieldKey := range slices.Sorted(slices.Values(fieldSet.fieldKeys())) {
+			field := fieldSet.fieldMap[fieldKey]
+			location := fmt.Sprintf("%s.%s", fieldSet.Key, field.Key)
+
+			if field.FlagShorthand != "" {
+				if isReservedFlag(field.FlagShorthand) {
+					errs = append(errs, fmt.Errorf(
+						"field '%s' flag shorthand '%s' is reserved", location, field.FlagShorthand,
+					))
+				} else if existing, found := shorthandOwner(shorthands[field.FlagShorthand], command); found {
+					errs = append(errs, fmt.Errorf(
+						"field '%s' flag shorthand '%s' already used by field '%s'", location, field.FlagShorthand, existing,
+					))
+				}
+
+				if shorthands[field.FlagShorthand] == nil {
+					shorthands[field.FlagShorthand] = map[string]string{}
+				}
+
+				shorthands[field.FlagShorthand][command] = location
+			}
+
+			loaderFields = append(loaderFields, LoaderField{
+				FieldSetKey:   fieldSet.Key,
+				FieldKey:      field.Key,
+				FieldType:     field.Type,
+				FlagShorthand: field.FlagShorthand,
+				ListDelimiter: field.listDelimiter(),
+				Command:       command,
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	for _, loader := range c.loaders {
+		if fieldAwareLoader, ok := loader.(FieldAwareLoader); ok {
+			fieldAwareLoader.SetFields(loaderFields)
+		}
+	}
+
+	return nil
+}
+
+// loaderErrors collects source errors from loaders implementing the ErrorReportingLoader interface.
+func (c *AppConfig) loaderErrors() []error {
+	errs := []error{}
+
+	for _, loader := range c.loaders {
+		if errorReportingLoader, ok := loader.(ErrorReportingLoader); ok {
+			for _, err := range errorReportingLoader.Errors() {
+				errs = append(errs, fmt.Errorf("loader '%s' error: %w", loader.Name(), err))
+			}
+		}
+	}
+
+	return errs
+}
+
+// prepareLoaders prepares loaders implementing LifecycleLoader to read their sources once, returning a function that
+// finishes the prepared loaders.
+func (c *AppConfig) prepareLoaders() func() {
+	lifecycleLoaders := []LifecycleLoader{}
+
+	for _, loader := range c.loaders {
+		if lifecycleLoader, ok := loader.(LifecycleLoader); ok {
+			lifecycleLoader.Prepare()
+			lifecycleLoaders = append(lifecycleLoaders, lifecycleLoader)
+		}
+	}
+
+	return func() {
+		for _, lifecycleLoader := range lifecycleLoaders {
+			lifecycleLoader.Finish()
+		}
+	}
+}
+
+// stage returns a copy of the configuration with cloned field-sets, field-set groups, and loaders, which can be loaded
+// without holding the lock while readers continue to use the current configuration. Callers must hold the change lock
+// until the staged configuration is committed.
+func (c *AppConfig) stage() *AppConfig {
+	staged := &AppConfig{
+		fieldSets:        make(map[string]*FieldSet, len(c.fieldSets)),
+		fieldSetCommands: maps.Clone(c.fieldSetCommands),
+		fieldSetGroups:   make(fieldSetGroups, len(c.fieldSetGroups)),
+		commands:         c.commands,
+		loaders:          make([]Loader, len(c.loaders)),
+		fillStructs:      c.fillStructs,
+		warnings:         slices.Clone(c.warnings),
+		logger:           c.logger,
+		hooks:            c.hooks,
+		orderedFieldSets: make(FieldSets, len(c.orderedFieldSets)),
+		selectedCommand:  c.selectedCommand,
+		helpOptions:      c.helpOptions,
+		generation:       c.generation,
+		loaded:           c.loaded,
+	}
+
+	for idx, fieldSet := range c.orderedFieldSets {
+		staged.orderedFieldSets[idx] = fieldSet.Clone()
+		staged.fieldSets[fieldSet.Key] = staged.orderedFieldSets[idx]
+	}
+
+	for idx, group := range c.fieldSetGroups {
+		stagedGroup := *group
+		staged.fieldSetGroups[idx] = &stagedGroup
+	}
+
+	for idx, loader := range c.loaders {
+		staged.loaders[idx] = loader.CloneLoader()
+	}
+
+	return staged
+}
+
+// commit swaps in the state of a staged configuration, binding its fields to the AppConfig lock and publishing its
+// snapshot when a new generation was loaded.
+func (c *AppConfig) commit(staged *AppConfig) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.fieldSets = staged.fieldSets
+	c.fieldSetCommands = staged.fieldSetCommands
+	c.fieldSetGroups = staged.fieldSetGroups
+	c.loaders = staged.loaders
+	c.warnings = staged.warnings
+	c.orderedFieldSets = staged.orderedFieldSets
+	c.selectedCommand = staged.selectedCommand
+	c.loaded = staged.loaded
+
+	for _, fieldSet := range c.orderedFieldSets {
+		for _, field := range fieldSet.fieldMap {
+			field.lock = &c.lock
+		}
+	}
+
+	if staged.generation != c.generation {
+		c.generation = staged.generation
+		c.snapshot.Store(staged.snapshot.Load())
+	}
+}
+
+// setOverride sets a checked override value on a registered field, publishing a new snapshot once loaded. The override
+// is logged after the lock is released, allowing log handlers to read the AppConfig.
+func (c *AppConfig) setOverride(fieldSetKey string, field *Field, value any) {
+	c.lock.Lock()
+
+	previousValue := field.logValue()
+	field.overrideValue = value
+	currentValue := field.logValue()
+
+	if c.loaded {
+		c.publishSnapshot()
+	}
+
+	c.lock.Unlock()
+
+	c.logOverride(fieldSetKey, field.Key, previousValue, currentValue)
+}
+
+// publishSnapshot atomically replaces the current configuration snapshot with a new generation.
+func (c *AppConfig) publishSnapshot() {
+	c.generation++
+	c.snapshot.Store(newConfigSnapshot(c.fieldSets, c.generation))
+}
+
+// fieldSetLoaders returns the loaders used to load the field-set, ordered from lowest to highest priority.
+func (c *AppConfig) fieldSetLoaders(fieldSet *FieldSet) []Loader {
+	if len(fieldSet.LoaderPrecedence) < 1 {
+		return c.loaders
+	}
+
+	loaders, _ := orderLoaders(c.loaders, fieldSet.LoaderPrecedence)
+
+	return loaders
+}
+
+// activateProfile sets the active profile from the 'app.profile' field value on all field-sets and profile loaders.
+func (c *AppConfig) activateProfile() {
+	profile := c.appValue("profile")
+
+	for _, fieldSet := range c.fieldSets {
+		fieldSet.setProfile(profile)
+	}
+
+	for _, loader := range c.loaders {
+		if profileLoader, ok := loader.(ProfileLoader); ok {
+			profileLoader.SetProfile(profile)
+		}
+	}
+}
+
 func (c *AppConfig) shouldLoadFieldSet(fieldSet *FieldSet) (loadFieldSet bool, err error) {
 	loadFieldSet = true
 
@@ -818,7 +1456,7 @@ func (c *AppConfig) shouldLoadFieldSet(fieldSet *FieldSet) (loadFieldSet bool, e
 		}
 
 		for _, dependency := range dependencies {
-			fieldValue, err := c.getFieldValue(dependency.FieldSetKey, dependency.FieldKey, "any")
+			fieldValue, err := c.findFieldValue(dependency.FieldSetKey, dependency.FieldKey, "any")
 			if err != nil {
 				return false, fmt.Errorf(
 					"problem getting field value for field-set '%s' load condition: %w", fieldSet.Key, err,
@@ -865,7 +1503,7 @@ func (c *AppConfig) shouldLoadField(field *Field, fieldSetKey string) (loadField
 				dependency.FieldSetKey = fieldSetKey
 			}
 
-			fieldValue, err := c.getFieldValue(dependency.FieldSetKey, dependency.FieldKey, "any")
+			fieldValue, err := c.findFieldValue(dependency.FieldSetKey, dependency.FieldKey, "any")
 			if err != nil {
 				return false, fmt.Errorf(
 					"problem getting field value for field-set '%s' field '%s' load condition: %w",
@@ -891,7 +1529,30 @@ func (c *AppConfig) shouldLoadField(field *Field, fieldSetKey string) (loadField
 }
 
 func (c *AppConfig) getFieldValue(fieldSetKey, fieldKey, expectedType string) (any, error) {
-	field, err := c.GetField(fieldSetKey, fieldKey)
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.findFieldValue(fieldSetKey, fieldKey, expectedType)
+}
+
+// findField looks up a field without acquiring the config lock.
+func (c *AppConfig) findField(fieldSetKey, fieldKey string) (*Field, error) {
+	fieldSet, found := c.fieldSets[fieldSetKey]
+	if !found {
+		return nil, fmt.Errorf("field-set not found with key '%s'", fieldSetKey)
+	}
+
+	field, found := fieldSet.fieldMap[fieldKey]
+	if !found {
+		return nil, fmt.Errorf("field-set field not found with key '%s'", fieldKey)
+	}
+
+	return field, nil
+}
+
+// findFieldValue looks up a field value without acquiring the config lock.
+func (c *AppConfig) findFieldValue(fieldSetKey, fieldKey, expectedType string) (any, error) {
+	field, err := c.findField(fieldSetKey, fieldKey)
 	if err != nil {
 		return nil, err
 	}
@@ -908,196 +1569,79 @@ func (c *AppConfig) getFieldValue(fieldSetKey, fieldKey, expectedType string) (a
 	return fieldValue, nil
 }
 
-func (c *AppConfig) printHelpString() {
-	fmt.Printf("%s", c.HelpString())
-}
-
-type fieldEntry struct {
-	fieldSetKey    string
-	field          *Field
-	loadConditions LoadConditions
-}
-
-func (c *AppConfig) fields() map[string]*fieldEntry {
-	fields := map[string]*fieldEntry{}
-
-	for fieldSetKey, fieldSet := range c.fieldSets {
-		for _, field := range fieldSet.fieldMap {
-			entry := fieldEntry{field: field, fieldSetKey: fieldSetKey}
-
-			if len(fieldSet.LoadConditions) > 0 {
-				entry.loadConditions = fieldSet.LoadConditions
-			}
+// appValue returns an 'app' field-set string value without acquiring the config lock.
+func (c *AppConfig) appValue(fieldKey string) string {
+	fieldValue, _ := c.findFieldValue("app", fieldKey, String)
+	value, _ := fieldValue.(string)
 
-			if len(field.LoadConditions) > 0 {
-				entry.loadConditions = append(entry.loadConditions, field.LoadConditions...)
-			}
-
-			fields[fmt.Sprintf("%s.%s", fieldSetKey, field.Key)] = &entry
-		}
-	}
-
-	return fields
+	return value
 }
 
-func (c *AppConfig) addFieldsToBuilder(builder *strings.Builder, maxCharLength int) {
-	fields := c.fields()
-	if len(fields) > 0 {
-		keys := make([]string, len(fields))
-		idx := 0
-
-		for key := range fields {
-			keys[idx] = key
-			idx++
-		}
-
-		sort.Strings(keys)
-
-		conditionallyRequiredFields := []string{}
-		requiredFields := []string{}
-		optionalFields := []string{}
-
-		for _, key := range keys {
-			fieldEntry := fields[key]
-
-			switch {
-			case fieldEntry.field.Required && fieldEntry.loadConditions == nil:
-				requiredFields = append(requiredFields, key)
-			case fieldEntry.field.Required && fieldEntry.loadConditions != nil:
-				conditionallyRequiredFields = append(conditionallyRequiredFields, key)
-			default:
-				optionalFields = append(optionalFields, key)
-			}
-		}
-
-		if len(requiredFields) > 0 {
-			builder.WriteString("Required Configuration:\n")
-
-			for _, key := range requiredFields {
-				fmt.Fprintf(builder, "\t%s", c.fieldHelpString(fields, key, maxCharLength))
-			}
-		}
-
-		if len(conditionallyRequiredFields) > 0 {
-			builder.WriteString("Conditionally Required Configuration:\n")
-
-			for _, key := range conditionallyRequiredFields {
-				fmt.Fprintf(builder, "\t%s", c.fieldHelpString(fields, key, maxCharLength))
-			}
-		}
-
-		if len(optionalFields) > 0 {
-			builder.WriteString("Optional Configuration:\n")
-
-			for _, key := range optionalFields {
-				if key == "app.name" || key == "app.description" {
-					continue
-				}
+// orderLoaders orders loaders by the provided loader names (lowest to highest priority). Loaders that are not named
+// keep their relative order ahead of named loaders. Loader names that do not match a loader are returned.
+func orderLoaders(loaders []Loader, loaderNames []string) (ordered []Loader, unknownLoaderNames []string) {
+	ordered = make([]Loader, 0, len(loaders))
+	named := make([]Loader, 0, len(loaderNames))
 
-				fmt.Fprintf(builder, "\t%s", c.fieldHelpString(fields, key, maxCharLength))
-			}
+	for _, loaderName := range loaderNames {
+		idx := slices.IndexFunc(loaders, func(loader Loader) bool { return loader.Name() == loaderName })
+		if idx < 0 {
+			unknownLoaderNames = append(unknownLoaderNames, loaderName)
+			continue
 		}
-	}
-}
-
-func (c *AppConfig) fieldHelpString(fields map[string]*fieldEntry, key string, maxCharLength int) string {
-	entry := fields[key]
-	field := entry.field
-	loadConditions := entry.loadConditions
 
-	if field == nil {
-		return "no field matching key"
+		named = append(named, loaders[idx])
 	}
 
-	builder := strings.Builder{}
-	spaceBuffer := "\t\t"
-
-	builder.WriteString(fmt.Sprintf("%s %s\n", key, field.Type))
-
-	if field.Description != "" {
-		builder.WriteString(spaceBuffer)
-
-		if len(spaceBuffer)+len(field.Description) > maxCharLength {
-			wrapStringForBuilder(field.Description, &builder, maxCharLength, spaceBuffer)
-		} else {
-			builder.WriteString(fmt.Sprintf("%s\n", field.Description))
+	for _, loader := range loaders {
+		if !slices.Contains(loaderNames, loader.Name()) {
+			ordered = append(ordered, loader)
 		}
 	}
 
-	if len(field.Enumeration) > 0 {
-		builder.WriteString(spaceBuffer)
-		builder.WriteString(fmt.Sprintf("Accepted values: %s\n", field.enumerationString()))
-	}
-
-	if field.Default != nil && field.Sensitive {
-		builder.WriteString(spaceBuffer)
-		builder.WriteString("Default value: '<sensitive-value>'\n")
-	} else if field.Default != nil {
-		builder.WriteString(spaceBuffer)
-		builder.WriteString(fmt.Sprintf("Default value: '%v'\n", field.Default))
-	}
+	return append(ordered, named...), unknownLoaderNames
+}
 
-	if field.DefaultGenerator != nil {
-		builder.WriteString(spaceBuffer)
-		builder.WriteString("Default value: <generated-at-run-time>\n")
-	}
+// generateFlagValue returns the value of a reserved generate flag ('--<flag> <value>' or '--<flag>=<value>').
+func generateFlagValue(args []string, flag string) (string, bool) {
+	for idx, arg := range args {
+		switch {
+		case arg == "--":
+			return "", false
+		case arg == "--"+flag:
+			if idx+1 < len(args) {
+				return args[idx+1], true
+			}
 
-	for _, loader := range c.loaders {
-		helpString := loader.HelpString(entry.fieldSetKey, entry.field.Key)
-		if helpString != "" {
-			builder.WriteString(spaceBuffer)
-			builder.WriteString(fmt.Sprintf("%s\n", helpString))
+			return "", true
+		case strings.HasPrefix(arg, "--"+flag+"="):
+			return strings.TrimPrefix(arg, "--"+flag+"="), true
 		}
 	}
 
-	for _, condition := range loadConditions {
-		dependencies := condition.FieldDependencies()
-
-		if len(dependencies) > 0 {
-			builder.WriteString(spaceBuffer)
-			builder.WriteString("Loading depends on field(s): ")
-
-			for idx, dependency := range dependencies {
-				if idx == 0 {
-					builder.WriteString(fmt.Sprintf("'%s.%s'", dependency.FieldSetKey, dependency.FieldKey))
-				} else {
-					builder.WriteString(fmt.Sprintf(", '%s.%s'", dependency.FieldSetKey, dependency.FieldKey))
-				}
-			}
+	return "", false
+}
 
-			builder.WriteString("\n")
-		} else {
-			builder.WriteString(spaceBuffer)
-			builder.WriteString("Loading depends on: <custom-load-condition-function>\n")
+// shorthandOwner finds a field using a shorthand that conflicts with the provided command scope. Shared fields
+// (empty command) conflict with every command.
+func shorthandOwner(owners map[string]string, command string) (string, bool) {
+	for ownerCommand, location := range owners {
+		if ownerCommand == command || ownerCommand == "" || command == "" {
+			return location, true
 		}
 	}
 
-	return builder.String()
+	return "", false
 }
 
-func wrapStringForBuilder(content string, builder *strings.Builder, maxCharLength int, spaceBuffer string) {
-	maxCharLength -= len(spaceBuffer)
-
-	words := strings.Split(content, " ")
-	chunkLen := 0
-
-	for _, word := range words {
-		wordLen := len(word) + 1
-
-		if chunkLen+wordLen > maxCharLength {
-			builder.WriteString("\n")
-			builder.WriteString(spaceBuffer)
-			fmt.Fprintf(builder, "%s ", word)
-
-			chunkLen = 0
-
-			continue
-		}
-
-		fmt.Fprintf(builder, "%s ", word)
+// helpRequested checks whether the first argument, or the first argument following the selected command, is a help
+// flag.
+func helpRequested(args []string, command string) bool {
+	isHelpFlag := func(arg string) bool { return arg == "--help" || arg == "-h" }
 
-		chunkLen += wordLen
+	if len(args) > 0 && isHelpFlag(args[0]) {
+		return true
 	}
 
-	builder.WriteString("\n")
+	return command != "" && len(args) > 1 && args[0] == command && isHelpFlag(args[1])
 }
@@ -1,6 +1,10 @@
 package bconf_test
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -1666,6 +1670,586 @@ func testHelperCheckValidConfigStructB(t *testing.T, configStructB *ValidConfigB
 	}
 }
 
+func TestAppConfigProfile(t *testing.T) {
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithAppProfile("dev"),
+		bconf.WithEnvironmentLoader("bconf_profile_test"),
+		bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_03.json"),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).C(),
+		bconf.FB("log_level", bconf.String).Default("info").ProfileDefault("dev", "debug").C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	// The JSON fixture sets 'app.profile' to 'prod', overriding the default 'dev' profile
+	if profile := appConfig.AppProfile(); profile != "prod" {
+		t.Fatalf("unexpected app profile '%s', expected 'prod'", profile)
+	}
+
+	if host, _ := appConfig.GetString("api", "host"); host != "api.example.com" {
+		t.Errorf("unexpected api host '%s', expected value from profile file 'api.example.com'", host)
+	}
+
+	if logLevel, _ := appConfig.GetString("api", "log_level"); logLevel != "info" {
+		t.Errorf("unexpected api log level '%s', expected base default 'info'", logLevel)
+	}
+
+	if configMap := appConfig.ConfigMap(); configMap["app"]["profile"] != "prod" {
+		t.Errorf("unexpected config map app profile '%v', expected 'prod'", configMap["app"]["profile"])
+	}
+
+	if !strings.Contains(appConfig.HelpString(), "Active profile: 'prod'") {
+		t.Errorf("expected active profile in help string: %s", appConfig.HelpString())
+	}
+}
+
+func TestAppConfigProfileDefault(t *testing.T) {
+	t.Setenv("BCONF_PROFILE_TEST_APP_PROFILE", "dev")
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_profile_test"),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("log_level", bconf.String).Default("info").ProfileDefault("dev", "debug").
+			Enumeration("debug", "info").C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if logLevel, _ := appConfig.GetString("api", "log_level"); logLevel != "debug" {
+		t.Errorf("unexpected api log level '%s', expected 'dev' profile default 'debug'", logLevel)
+	}
+
+	invalidConfig := createBaseAppConfig()
+
+	invalidConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("log_level", bconf.String).Default("info").ProfileDefault("dev", "trace").
+			Enumeration("debug", "info").C(),
+	).C())
+
+	if errs := invalidConfig.Load(); len(errs) < 1 {
+		t.Fatalf("expected error loading app config with profile default outside of enumeration")
+	}
+}
+
+func TestAppConfigLoaderPrecedence(t *testing.T) {
+	t.Setenv("BCONF_PRECEDENCE_TEST_API_HOST", "env.example.com")
+
+	newAppConfig := func(options ...bconf.ConfigOption) *bconf.AppConfig {
+		options = append(
+			[]bconf.ConfigOption{
+				bconf.WithEnvironmentLoader("bconf_precedence_test"),
+				bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_03.json"),
+			},
+			options...,
+		)
+
+		return bconf.NewAppConfig("testapp", "testapp description", options...)
+	}
+
+	appConfig := newAppConfig()
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("host", bconf.String).C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if host, _ := appConfig.GetString("api", "host"); host != "api.example.com" {
+		t.Errorf("unexpected api host '%s', expected JSON file value 'api.example.com'", host)
+	}
+
+	appConfig = newAppConfig(bconf.WithLoaderPrecedence(bconf.LoaderNameJSONFile, bconf.LoaderNameEnvironment))
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("host", bconf.String).C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if host, _ := appConfig.GetString("api", "host"); host != "env.example.com" {
+		t.Errorf("unexpected api host '%s', expected environment value 'env.example.com'", host)
+	}
+
+	appConfig = newAppConfig()
+	appConfig.AddFieldSet(
+		bconf.FSB("api").Fields(bconf.FB("host", bconf.String).C()).
+			LoaderPrecedence(bconf.LoaderNameEnvironment).C(),
+	)
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if host, _ := appConfig.GetString("api", "host"); host != "env.example.com" {
+		t.Errorf("unexpected api host '%s', expected field-set precedence environment value", host)
+	}
+
+	appConfig = newAppConfig(bconf.WithLoaderPrecedence("unknown_loader"))
+
+	if len(appConfig.Warnings()) != 1 {
+		t.Errorf("unexpected warnings for unknown loader precedence: %v", appConfig.Warnings())
+	}
+
+	appConfig = newAppConfig()
+	appConfig.AddFieldSet(
+		bconf.FSB("api").Fields(bconf.FB("host", bconf.String).C()).LoaderPrecedence("unknown_loader").C(),
+	)
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if len(appConfig.Warnings()) != 1 {
+		t.Errorf("unexpected warnings for unknown field-set loader precedence: %v", appConfig.Warnings())
+	}
+}
+
+func TestAppConfigFieldLoaderRestrictions(t *testing.T) {
+	t.Setenv("BCONF_RESTRICTION_TEST_API_HOST", "env.example.com")
+
+	newAppConfig := func(hostField *bconf.Field) *bconf.AppConfig {
+		appConfig := bconf.NewAppConfig(
+			"testapp",
+			"testapp description",
+			bconf.WithEnvironmentLoader("bconf_restriction_test"),
+			bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_03.json"),
+		)
+
+		appConfig.AddFieldSet(bconf.FSB("api").Fields(hostField).C())
+
+		return appConfig
+	}
+
+	appConfig := newAppConfig(bconf.FB("host", bconf.String).DeniedLoaders(bconf.LoaderNameEnvironment).C())
+
+	errs := appConfig.Load()
+	if len(errs) != 1 {
+		t.Fatalf("expected a single error loading denied loader value, found: %v", errs)
+	}
+
+	if !strings.Contains(errs[0].Error(), bconf.LoaderNameEnvironment) {
+		t.Errorf("unexpected denied loader error: %s", errs[0])
+	}
+
+	appConfig = newAppConfig(bconf.FB("host", bconf.String).AllowedLoaders(bconf.LoaderNameEnvironment).C())
+
+	if errs = appConfig.Load(); len(errs) != 1 {
+		t.Fatalf("expected a single error loading value from loader not in allowed loaders, found: %v", errs)
+	}
+
+	if strings.Contains(appConfig.HelpString(), "JSON attribute: api.host") {
+		t.Errorf("unexpected help string for loader not in allowed loaders")
+	}
+
+	appConfig = newAppConfig(
+		bconf.FB("host", bconf.String).
+			AllowedLoaders(bconf.LoaderNameJSONFile).DeniedLoaders(bconf.LoaderNameEnvironment).C(),
+	)
+
+	if errs = appConfig.Load(); len(errs) < 1 {
+		t.Fatalf("expected error adding field with both allowed and denied loaders")
+	}
+}
+
+func TestAppConfigConcurrentAccess(t *testing.T) {
+	appConfig := createBaseAppConfig()
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).Default("localhost").C(),
+		bconf.FB("port", bconf.Int).Default(8080).C(),
+		bconf.FB("read_timeout", bconf.Duration).Default(5*time.Second).C(),
+		bconf.FB("db_switch_time", bconf.Time).Default(time.Now()).C(),
+		bconf.FB("debug_mode", bconf.Bool).Default(true).C(),
+		bconf.FB("log_prefix", bconf.String).C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	wg := sync.WaitGroup{}
+	readers := []func(){
+		func() { _, _ = appConfig.GetInt("api", "port") },
+		func() { _, _ = appConfig.GetString("api", "host") },
+		func() { _, _ = appConfig.GetField("api", "port") },
+		func() { _ = appConfig.AppName() },
+		func() { _ = appConfig.ConfigMap() },
+		func() { _ = appConfig.HelpString() },
+		func() { _ = appConfig.Warnings() },
+		func() { _ = appConfig.FillStruct(&ValidConfigA{}) },
+	}
+
+	for _, reader := range readers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range 50 {
+				reader()
+			}
+		}()
+	}
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		for port := range 50 {
+			if err := appConfig.SetField("api", "port", port); err != nil {
+				t.Errorf("unexpected error setting field: %s", err)
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		for range 10 {
+			if errs := appConfig.Load(); len(errs) > 0 {
+				t.Errorf("unexpected error(s) reloading app config: %v", errs)
+			}
+		}
+	}()
+
+	wg.Wait()
+}
+
+func TestAppConfigCallbacksReadConfig(t *testing.T) {
+	var appConfig *bconf.AppConfig
+
+	reads := []string{}
+	read := func(callback string) {
+		name, _ := appConfig.GetString("app", "name")
+		reads = append(reads, fmt.Sprintf("%s:%s", callback, name))
+	}
+
+	appConfig = bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_callback_test"),
+		bconf.WithHooks(bconf.Hooks{
+			AfterFieldSetLoad: func(fieldSetKey string) error {
+				if fieldSetKey == "api" {
+					read("hook")
+				}
+
+				return nil
+			},
+		}),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).DefaultGenerator(func() (any, error) {
+			read("generator")
+
+			return "localhost", nil
+		}).C(),
+		bconf.FB("port", bconf.Int).Default(8080).Validator(func(_ any) error {
+			read("validator")
+
+			return nil
+		}).C(),
+	).LoadConditions(
+		bconf.LCB(func(_ bconf.FieldValueFinder) (bool, error) {
+			read("condition")
+
+			return true, nil
+		}).C(),
+	).C())
+
+	done := make(chan []error)
+
+	go func() {
+		errs := appConfig.Load()
+
+		if err := appConfig.SetField("api", "port", 8081); err != nil {
+			errs = append(errs, err)
+		}
+
+		_, reloadErrs := appConfig.ReloadFieldSet("api")
+
+		done <- append(errs, reloadErrs...)
+	}()
+
+	select {
+	case errs := <-done:
+		if len(errs) > 0 {
+			t.Fatalf("unexpected error(s) loading app config: %v", errs)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("callbacks reading the app config did not return")
+	}
+
+	// callbacks observe the configuration from before the running load, set, or reload
+	expectedReads := []string{
+		"generator:", "validator:", "condition:", "hook:", "validator:testapp", "condition:testapp", "hook:testapp",
+	}
+
+	if !slices.Equal(reads, expectedReads) {
+		t.Errorf("unexpected callback reads %v, expected %v", reads, expectedReads)
+	}
+}
+
+func TestAppConfigFlagShorthands(t *testing.T) {
+	appConfig := createBaseAppConfig()
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("port", bconf.Int).FlagShorthand("p").C(),
+		bconf.FB("max_port", bconf.Int).FlagShorthand("p").C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) != 1 {
+		t.Fatalf("expected a single error for duplicate flag shorthands, found: %v", errs)
+	}
+
+	appConfig = createBaseAppConfig()
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("port", bconf.Int).FlagShorthand("h").C()).C())
+
+	if errs := appConfig.Load(); len(errs) != 1 {
+		t.Fatalf("expected a single error for reserved flag shorthand, found: %v", errs)
+	}
+
+	appConfig = createBaseAppConfig()
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("port", bconf.Int).FlagShorthand("pp").C()).C())
+
+	if errs := appConfig.Load(); len(errs) != 1 {
+		t.Fatalf("expected a single error for invalid flag shorthand, found: %v", errs)
+	}
+}
+
+func TestAppConfigFieldSetGroups(t *testing.T) {
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_group_test"),
+		bconf.WithHelpOptions(bconf.HelpOptions{GroupBy: bconf.HelpGroupByFieldSetGroup, HideAppFields: true}),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("features").Fields(bconf.FB("metrics", bconf.Bool).Default(false).C()).C())
+
+	appConfig.AddFieldSetGroups(
+		bconf.FSGB("metrics").Description("metrics exporter configuration").Order(2).LoadConditions(
+			bconf.LCB(func(f bconf.FieldValueFinder) (bool, error) {
+				enabled, _ := f.GetFieldValue("features", "metrics")
+				value, _ := enabled.(bool)
+
+				return value, nil
+			}).AddFieldDependencies(bconf.FD("features", "metrics")).C(),
+		).FieldSets(
+			bconf.FSB("prometheus").Fields(bconf.FB("port", bconf.Int).Required().C()).C(),
+		).C(),
+		bconf.FSGB("server").Description("server configuration").Order(1).FieldSets(
+			bconf.FSB("api").Fields(bconf.FB("port", bconf.Int).Default(8080).C()).C(),
+		).C(),
+	)
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config with disabled group: %v", errs)
+	}
+
+	groups := appConfig.FieldSetGroups()
+
+	expectedNames := []string{"app", "features", "server", "metrics"}
+	if len(groups) != len(expectedNames) {
+		t.Fatalf("unexpected field-set groups: %v", groups)
+	}
+
+	for idx, name := range expectedNames {
+		if groups[idx].Name != name {
+			t.Errorf("unexpected field-set group at index %d: expected '%s', found '%s'", idx, name, groups[idx].Name)
+		}
+	}
+
+	if metrics := groups[3]; !metrics.Conditional || metrics.Description != "metrics exporter configuration" ||
+		len(metrics.FieldSetKeys) != 1 || metrics.FieldSetKeys[0] != "prometheus" {
+		t.Errorf("unexpected metrics field-set group info: %v", metrics)
+	}
+
+	helpString := appConfig.HelpString()
+
+	if !strings.Contains(helpString, "server Configuration:\nserver configuration\n\tapi.port int") ||
+		strings.Index(helpString, "server Configuration:") > strings.Index(helpString, "metrics Configuration:") {
+		t.Errorf("expected ordered field-set group help sections, found:\n%s", helpString)
+	}
+
+	t.Setenv("BCONF_GROUP_TEST_FEATURES_METRICS", "true")
+
+	if errs := appConfig.Load(); len(errs) != 1 {
+		t.Fatalf("expected a single error loading enabled group without required field, found: %v", errs)
+	}
+}
+
+func TestAppConfigSetFieldString(t *testing.T) {
+	appConfig := createBaseAppConfig()
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("timeout", bconf.Duration).Default(time.Second).C(),
+		bconf.FB("hosts", bconf.Strings).C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if err := appConfig.SetFieldString("api", "timeout", "30s"); err != nil {
+		t.Fatalf("unexpected error setting field from string: %s", err)
+	}
+
+	if timeout, _ := appConfig.GetDuration("api", "timeout"); timeout != 30*time.Second {
+		t.Errorf("unexpected field value '%s', expected '30s'", timeout)
+	}
+
+	if err := appConfig.SetFieldString("api", "hosts", "a,b"); err != nil {
+		t.Fatalf("unexpected error setting list field from string: %s", err)
+	}
+
+	if hosts, _ := appConfig.GetStrings("api", "hosts"); len(hosts) != 2 || hosts[1] != "b" {
+		t.Errorf("unexpected list field value: %v", hosts)
+	}
+
+	if err := appConfig.SetFieldString("api", "timeout", "invalid"); err == nil {
+		t.Errorf("expected error setting field from invalid string")
+	}
+
+	if err := appConfig.SetFieldString("api", "missing", "value"); err == nil {
+		t.Errorf("expected error setting missing field from string")
+	}
+}
+
+func TestAppConfigFieldEnumerationMatching(t *testing.T) {
+	t.Setenv("BCONF_ENUMERATION_TEST_API_LOG_LEVEL", "INFO")
+	t.Setenv("BCONF_ENUMERATION_TEST_API_FEATURES", "Metrics,tracing")
+	t.Setenv("BCONF_ENUMERATION_TEST_API_PORTS", "8080,8081")
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_enumeration_test"),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("log_level", bconf.String).EnumerationEntries(
+			bconf.EnumerationEntry{Value: "debug", Description: "verbose output"},
+			bconf.EnumerationEntry{Value: "info", Description: "standard output"},
+		).EnumerationCaseInsensitive().C(),
+		bconf.FB("features", bconf.Strings).Default([]string{"metrics"}).
+			Enumeration("metrics", "tracing").EnumerationCaseInsensitive().C(),
+		bconf.FB("ports", bconf.Ints).Enumeration(8080, 8081).C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if logLevel, _ := appConfig.GetString("api", "log_level"); logLevel != "info" {
+		t.Errorf("unexpected log level '%s', expected normalized value 'info'", logLevel)
+	}
+
+	if features, _ := appConfig.GetStrings("api", "features"); len(features) != 2 || features[0] != "metrics" ||
+		features[1] != "tracing" {
+		t.Errorf("unexpected features '%v', expected normalized values '[metrics tracing]'", features)
+	}
+
+	if err := appConfig.SetField("api", "ports", []int{8080, 9090}); err == nil {
+		t.Error("expected error setting list value with element outside of enumeration")
+	}
+
+	if err := appConfig.SetFieldString("api", "features", "metrics,logs"); err == nil {
+		t.Error("expected error setting list value with element outside of enumeration")
+	}
+
+	helpString := appConfig.HelpString()
+
+	if !strings.Contains(helpString, "Accepted values (case-insensitive): ['debug' (verbose output), 'info' (standard") {
+		t.Errorf("expected enumeration descriptions in help string: %s", helpString)
+	}
+
+	if !strings.Contains(helpString, "Accepted values: ['8080', '8081']") {
+		t.Errorf("expected list enumeration values in help string: %s", helpString)
+	}
+
+	invalidConfig := createBaseAppConfig()
+
+	invalidConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("features", bconf.Strings).Enumeration([]string{"metrics"}).C(),
+	).C())
+
+	if errs := invalidConfig.Load(); len(errs) < 1 {
+		t.Fatal("expected error loading app config with list enumeration values of the list field-type")
+	}
+
+	invalidConfig = createBaseAppConfig()
+
+	invalidConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("features", bconf.Strings).EnumerationEntries(
+			bconf.EnumerationEntry{Value: []string{"metrics"}, Description: "metrics collection"},
+		).C(),
+	).C())
+
+	errs := invalidConfig.Load()
+	if len(errs) < 1 || !strings.Contains(errs[0].Error(), "invalid enumeration value type") {
+		t.Fatalf("expected enumeration value type error loading app config with list enumeration entries: %v", errs)
+	}
+}
+
+func TestAppConfigFailedReloadKeepsValues(t *testing.T) {
+	t.Setenv("BCONF_FAILED_RELOAD_TEST_API_HOST", "api.example.com")
+	t.Setenv("BCONF_FAILED_RELOAD_TEST_DB_PORT", "5432")
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_failed_reload_test"),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("host", bconf.String).Default("localhost").C()).C())
+	appConfig.AddFieldSet(bconf.FSB("db").Fields(bconf.FB("port", bconf.Int).C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	t.Setenv("BCONF_FAILED_RELOAD_TEST_API_HOST", "other.example.com")
+	t.Setenv("BCONF_FAILED_RELOAD_TEST_DB_PORT", "invalid")
+
+	appConfig.AddFieldSet(bconf.FSB("cache").Fields(bconf.FB("size", bconf.Int).Default(10).C()).C())
+
+	if errs := appConfig.Load(); len(errs) < 1 {
+		t.Fatal("expected error re-loading app config with an invalid value")
+	}
+
+	if host, _ := appConfig.GetString("api", "host"); host != "api.example.com" {
+		t.Errorf("unexpected host '%s' after failed load, expected previous value 'api.example.com'", host)
+	}
+
+	if port, _ := appConfig.GetInt("db", "port"); port != 5432 {
+		t.Errorf("unexpected port '%d' after failed load, expected previous value '5432'", port)
+	}
+
+	if host, _ := appConfig.Snapshot().GetString("api", "host"); host != "api.example.com" {
+		t.Errorf("unexpected snapshot host '%s' after failed load, expected previous value 'api.example.com'", host)
+	}
+
+	if size, _ := appConfig.GetInt("cache", "size"); size != 10 {
+		t.Errorf("unexpected cache size '%d', expected field-set added by the failed load to hold its default", size)
+	}
+}
+
 func createBaseAppConfig() *bconf.AppConfig {
 	appConfig := bconf.NewAppConfig(
 		"testapp",
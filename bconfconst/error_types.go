@@ -3,4 +3,8 @@ package bconfconst
 const (
 	ErrorFieldDefaultSetting      = "invalid settings: cannot set both Default and DefaultGenerator"
 	ErrorFieldRequiredWithDefault = "invalid settings: cannot set both Required and Default/DefaultGenerator"
+
+	ErrorFieldRequiredWithProfileDefault = "invalid settings: cannot set both Required and ProfileDefault"
+
+	ErrorFieldAllowedAndDeniedLoaders = "invalid settings: cannot set both AllowedLoaders and DeniedLoaders"
 )
@@ -0,0 +1,303 @@
+// Package bconfhttp provides an http.Handler exposing the effective configuration of a bconf.AppConfig for debugging.
+package bconfhttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/xavi-group/bconf"
+)
+
+const maxOverrideBodyBytes = 1 << 16
+
+// Options configures the debug handler.
+type Options struct {
+	// Mask masks sensitive field values (default: bconf.MaskFull)
+	Mask bconf.MaskStrategy
+	// Authorize gates field overrides, which are rejected when Authorize is nil or returns false
+	Authorize func(request *http.Request) bool
+	// AllowOverrides enables the field override endpoint for non-sensitive fields, which also requires Authorize
+	AllowOverrides bool
+}
+
+// NewHandler returns an http.Handler serving the effective configuration of an AppConfig, intended to be mounted with
+// http.StripPrefix (e.g. at '/debug/config/'). The handler serves:
+//
+//   - GET '/': field values (with sensitive values masked), value sources, and warnings, as JSON or as HTML when
+//     requested through the Accept header or the 'format=html' query parameter
+//   - GET '/schema': the AppConfig JSON Schema
+//   - POST '/fields/<field-set>/<field>': sets a non-sensitive field value from the request body, parsed using the
+//     field type, when overrides are enabled and authorized
+func NewHandler(config *bconf.AppConfig, options Options) http.Handler {
+	if options.Mask == nil {
+		options.Mask = bconf.MaskFull()
+	}
+
+	return &handler{config: config, options: options}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type handler struct {
+	config  *bconf.AppConfig
+	options Options
+}
+
+type configResponse struct {
+	FieldSets []fieldSetResponse `json:"field_sets"`
+	Warnings  []string           `json:"warnings"`
+}
+
+type fieldSetResponse struct {
+	Key     string          `json:"key"`
+	Command string          `json:"command,omitempty"`
+	Fields  []fieldResponse `json:"fields"`
+}
+
+type fieldResponse struct {
+	Value     any    `json:"value,omitempty"`
+	Key       string `json:"key"`
+	Type      string `json:"type"`
+	Source    string `json:"source,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+}
+
+func (h *handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	path := strings.Trim(request.URL.Path, "/")
+
+	switch {
+	case path == "":
+		h.serveConfig(writer, request)
+	case path == "schema":
+		h.serveSchema(writer, request)
+	case strings.HasPrefix(path, "fields/"):
+		h.serveOverride(writer, request, strings.TrimPrefix(path, "fields/"))
+	default:
+		http.NotFound(writer, request)
+	}
+}
+
+func (h *handler) serveConfig(writer http.ResponseWriter, request *http.Request) {
+	if !allowMethod(writer, request, http.MethodGet) {
+		return
+	}
+
+	response := h.configResponse()
+
+	if wantsHTML(request) {
+		writer.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+		if err := configTemplate.Execute(writer, response); err != nil {
+			http.Error(writer, err.Error(), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	writeJSON(writer, response)
+}
+
+func (h *handler) serveSchema(writer http.ResponseWriter, request *http.Request) {
+	if !allowMethod(writer, request, http.MethodGet) {
+		return
+	}
+
+	schema, err := h.config.JSONSchema()
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/schema+json")
+	_, _ = writer.Write(schema)
+}
+
+func (h *handler) serveOverride(writer http.ResponseWriter, request *http.Request, location string) {
+	if !h.options.AllowOverrides {
+		http.NotFound(writer, request)
+
+		return
+	}
+
+	if !allowMethod(writer, request, http.MethodPost) {
+		return
+	}
+
+	if h.options.Authorize == nil || !h.options.Authorize(request) {
+		http.Error(writer, "field overrides are not authorized", http.StatusForbidden)
+
+		return
+	}
+
+	fieldSetKey, fieldKey, found := strings.Cut(location, "/")
+	if !found || fieldSetKey == "" || fieldKey == "" || strings.Contains(fieldKey, "/") {
+		http.NotFound(writer, request)
+
+		return
+	}
+
+	field, err := h.config.GetField(fieldSetKey, fieldKey)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusNotFound)
+
+		return
+	}
+
+	if field.Sensitive {
+		http.Error(writer, "sensitive fields cannot be overridden", http.StatusForbidden)
+
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(writer, request.Body, maxOverrideBodyBytes))
+	if err != nil {
+		status := http.StatusBadRequest
+
+		maxBytesErr := &http.MaxBytesError{}
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+
+		http.Error(writer, fmt.Sprintf("problem reading request body: %s", err), status)
+
+		return
+	}
+
+	if err := h.config.SetFieldString(fieldSetKey, fieldKey, strings.TrimSpace(string(body))); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// configResponse describes the field-sets of the selected command, taking field values and sources from a single
+// description of the configuration so they always come from the same configuration generation.
+func (h *handler) configResponse() configResponse {
+	response := configResponse{Warnings: h.config.Warnings()}
+
+	if response.Warnings == nil {
+		response.Warnings = []string{}
+	}
+
+	command := h.config.SelectedCommand()
+
+	for _, fieldSet := range h.config.FieldSetsWithMask(h.options.Mask) {
+		if fieldSet.Command != "" && fieldSet.Command != command {
+			continue
+		}
+
+		fieldSetResponse := fieldSetResponse{Key: fieldSet.Key, Command: fieldSet.Command, Fields: []fieldResponse{}}
+
+		for _, field := range fieldSet.Fields {
+			fieldSetResponse.Fields = append(fieldSetResponse.Fields, fieldResponse{
+				Value:     responseValue(field.Value),
+				Key:       field.Key,
+				Type:      field.Type,
+				Source:    field.Source,
+				Sensitive: field.Sensitive,
+			})
+		}
+
+		response.FieldSets = append(response.FieldSets, fieldSetResponse)
+	}
+
+	return response
+}
+
+// responseValue formats durations as duration strings (e.g. '1m30s').
+func responseValue(value any) any {
+	switch typedValue := value.(type) {
+	case time.Duration:
+		return typedValue.String()
+	case []time.Duration:
+		values := make([]string, len(typedValue))
+
+		for idx, duration := range typedValue {
+			values[idx] = duration.String()
+		}
+
+		return values
+	default:
+		return value
+	}
+}
+
+func allowMethod(writer http.ResponseWriter, request *http.Request, method string) bool {
+	if request.Method == method || (method == http.MethodGet && request.Method == http.MethodHead) {
+		return true
+	}
+
+	writer.Header().Set("Allow", method)
+	http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+
+	return false
+}
+
+func wantsHTML(request *http.Request) bool {
+	switch request.URL.Query().Get("format") {
+	case "html":
+		return true
+	case "json":
+		return false
+	}
+
+	accept := request.Header.Get("Accept")
+
+	return strings.Contains(accept, "text/html") && !strings.Contains(accept, "application/json")
+}
+
+func writeJSON(writer http.ResponseWriter, value any) {
+	body := bytes.Buffer{}
+	encoder := json.NewEncoder(&body)
+
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(value); err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	_, _ = writer.Write(body.Bytes())
+}
+
+var configTemplate = template.Must(template.New("config").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Configuration</title>
+</head>
+<body>
+<h1>Configuration</h1>
+{{- if .Warnings }}
+<h2>Warnings</h2>
+<ul>
+{{- range .Warnings }}
+<li>{{ . }}</li>
+{{- end }}
+</ul>
+{{- end }}
+{{- range .FieldSets }}
+<h2>{{ .Key }}{{ if .Command }} (command '{{ .Command }}'){{ end }}</h2>
+<table>
+<tr><th>Field</th><th>Type</th><th>Value</th><th>Source</th></tr>
+{{- range .Fields }}
+<tr><td>{{ .Key }}</td><td>{{ .Type }}</td><td>{{ if ne .Value nil }}{{ .Value }}{{ end }}</td><td>{{ .Source }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+</body>
+</html>
+`))
@@ -0,0 +1,172 @@
+package bconfhttp_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+	"github.com/xavi-group/bconf/bconfhttp"
+)
+
+func TestHandler(t *testing.T) {
+	appConfig := newTestAppConfig(t)
+
+	server := httptest.NewServer(http.StripPrefix("/debug/config", bconfhttp.NewHandler(appConfig, bconfhttp.Options{
+		AllowOverrides: true,
+		Authorize:      func(request *http.Request) bool { return request.Header.Get("X-Debug-Token") == "allowed" },
+	})))
+	t.Cleanup(server.Close)
+
+	response := request(t, http.MethodGet, server.URL+"/debug/config/", "", nil)
+
+	config := struct {
+		FieldSets []struct {
+			Key    string
+			Fields []struct {
+				Value     any
+				Key       string
+				Source    string
+				Sensitive bool
+			}
+		} `json:"field_sets"`
+	}{}
+
+	if err := json.Unmarshal([]byte(response), &config); err != nil {
+		t.Fatalf("unexpected error decoding config response: %s\n%s", err, response)
+	}
+
+	if strings.Contains(response, "secret") || !strings.Contains(response, "<sensitive-value>") {
+		t.Errorf("expected sensitive values to be masked:\n%s", response)
+	}
+
+	if len(config.FieldSets) != 2 || config.FieldSets[1].Key != "api" ||
+		config.FieldSets[1].Fields[0].Key != "host" || config.FieldSets[1].Fields[0].Value != "localhost" ||
+		config.FieldSets[1].Fields[0].Source != bconf.FieldSourceDefault {
+		t.Errorf("unexpected config response:\n%s", response)
+	}
+
+	if html := request(t, http.MethodGet, server.URL+"/debug/config/?format=html", "", nil); !strings.Contains(
+		html, "<td>host</td><td>string</td><td>localhost</td><td>default</td>",
+	) {
+		t.Errorf("unexpected html config response:\n%s", html)
+	}
+
+	if schema := request(t, http.MethodGet, server.URL+"/debug/config/schema", "", nil); !strings.Contains(
+		schema, `"$schema"`,
+	) {
+		t.Errorf("unexpected schema response:\n%s", schema)
+	}
+
+	overrideURL := server.URL + "/debug/config/fields/api/timeout"
+	authorized := map[string]string{"X-Debug-Token": "allowed"}
+
+	if status := requestStatus(t, http.MethodPost, overrideURL, "45s", nil); status != http.StatusForbidden {
+		t.Errorf("unexpected unauthorized override status '%d'", status)
+	}
+
+	if status := requestStatus(t, http.MethodPost, overrideURL, "45s", authorized); status != http.StatusNoContent {
+		t.Errorf("unexpected override status '%d'", status)
+	}
+
+	if timeout, _ := appConfig.GetDuration("api", "timeout"); timeout.String() != "45s" {
+		t.Errorf("unexpected overridden field value '%s', expected '45s'", timeout)
+	}
+
+	if status := requestStatus(t, http.MethodPost, overrideURL, "invalid", authorized); status != http.StatusBadRequest {
+		t.Errorf("unexpected invalid override status '%d'", status)
+	}
+
+	sensitiveURL := server.URL + "/debug/config/fields/api/token"
+
+	if status := requestStatus(t, http.MethodPost, sensitiveURL, "value", authorized); status != http.StatusForbidden {
+		t.Errorf("unexpected sensitive override status '%d'", status)
+	}
+
+	if status := requestStatus(t, http.MethodGet, overrideURL, "", authorized); status != http.StatusMethodNotAllowed {
+		t.Errorf("unexpected override method status '%d'", status)
+	}
+
+	readOnlyServer := httptest.NewServer(bconfhttp.NewHandler(appConfig, bconfhttp.Options{}))
+	t.Cleanup(readOnlyServer.Close)
+
+	if status := requestStatus(t, http.MethodPost, readOnlyServer.URL+"/fields/api/timeout", "1s", nil); status !=
+		http.StatusNotFound {
+		t.Errorf("unexpected disabled override status '%d'", status)
+	}
+
+	unauthorizedServer := httptest.NewServer(bconfhttp.NewHandler(appConfig, bconfhttp.Options{AllowOverrides: true}))
+	t.Cleanup(unauthorizedServer.Close)
+
+	if status := requestStatus(t, http.MethodPost, unauthorizedServer.URL+"/fields/api/timeout", "1s", nil); status !=
+		http.StatusForbidden {
+		t.Errorf("unexpected override status '%d' without an authorize function", status)
+	}
+}
+
+func newTestAppConfig(t *testing.T) *bconf.AppConfig {
+	t.Helper()
+
+	appConfig := bconf.NewAppConfig("testapp", "testapp description", bconf.WithEnvironmentLoader("bconfhttp"))
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).Default("localhost").C(),
+		bconf.FB("timeout", bconf.Duration).C(),
+		bconf.FB("token", bconf.String).Default("secret").Sensitive().C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	return appConfig
+}
+
+func request(t *testing.T, method, url, body string, headers map[string]string) string {
+	t.Helper()
+
+	response, responseBody := doRequest(t, method, url, body, headers)
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status '%d' requesting '%s': %s", response.StatusCode, url, responseBody)
+	}
+
+	return responseBody
+}
+
+func requestStatus(t *testing.T, method, url, body string, headers map[string]string) int {
+	t.Helper()
+
+	response, _ := doRequest(t, method, url, body, headers)
+
+	return response.StatusCode
+}
+
+func doRequest(t *testing.T, method, url, body string, headers map[string]string) (*http.Response, string) {
+	t.Helper()
+
+	httpRequest, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("unexpected error creating request: %s", err)
+	}
+
+	for key, value := range headers {
+		httpRequest.Header.Set(key, value)
+	}
+
+	response, err := http.DefaultClient.Do(httpRequest)
+	if err != nil {
+		t.Fatalf("unexpected error requesting '%s': %s", url, err)
+	}
+
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading response body: %s", err)
+	}
+
+	return response, string(responseBody)
+}
@@ -0,0 +1,101 @@
+package bconf
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+const checkConfigFlag = "check-config"
+
+// checkConfigRequested checks whether the reserved '--check-config' flag was provided and is not disabled.
+func (c *AppConfig) checkConfigRequested(options []LoadOption) bool {
+	for _, option := range options {
+		if option.LoadOptionType() == loadOptionTypeDisableCheckConfigFlag {
+			return false
+		}
+	}
+
+	c.lock.RLock()
+	args := c.argsFlagLoader().args()
+	c.lock.RUnlock()
+
+	for _, arg := range args {
+		if arg == "--" {
+			return false
+		}
+
+		if arg == "--"+checkConfigFlag || arg == "--"+checkConfigFlag+"=true" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkConfigReport returns a report of load errors, warnings, and effective field values of the loaded field-sets,
+// masking sensitive values, and whether the configuration check failed.
+func (c *AppConfig) checkConfigReport(errs []error) (string, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	builder := strings.Builder{}
+
+	name := c.appValue("name")
+	if name == "" {
+		name = os.Args[0]
+	}
+
+	if len(errs) > 0 {
+		builder.WriteString(fmt.Sprintf("Configuration check for '%s' failed\n", name))
+	} else {
+		builder.WriteString(fmt.Sprintf("Configuration check for '%s' passed\n", name))
+	}
+
+	if c.selectedCommand != "" {
+		builder.WriteString(fmt.Sprintf("Command: '%s'\n", c.selectedCommand))
+	}
+
+	if len(errs) > 0 {
+		builder.WriteString(fmt.Sprintf("\nErrors (%d):\n", len(errs)))
+
+		for _, err := range errs {
+			builder.WriteString(fmt.Sprintf("\t%s\n", err))
+		}
+	}
+
+	if len(c.warnings) > 0 {
+		builder.WriteString(fmt.Sprintf("\nWarnings (%d):\n", len(c.warnings)))
+
+		for _, warning := range c.warnings {
+			builder.WriteString(fmt.Sprintf("\t%s\n", warning))
+		}
+	}
+
+	builder.WriteString("\nEffective values:\n")
+
+	for _, fieldSet := range c.orderedFieldSets {
+		if !c.fieldSetInCommandScope(fieldSet.Key, c.selectedCommand) {
+			continue
+		}
+
+		for _, fieldKey := range slices.Sorted(slices.Values(fieldSet.fieldKeys())) {
+			field := fieldSet.fieldMap[fieldKey]
+			location := fmt.Sprintf("%s.%s", fieldSet.Key, field.Key)
+
+			value, err := field.getValue()
+
+			switch {
+			case err != nil:
+				builder.WriteString(fmt.Sprintf("\t%s: <unset>\n", location))
+			case field.Sensitive:
+				builder.WriteString(fmt.Sprintf("\t%s: '<sensitive-value>'\n", location))
+			default:
+				builder.WriteString(fmt.Sprintf("\t%s: '%v'\n", location, value))
+			}
+		}
+	}
+
+	return builder.String(), len(errs) > 0
+}
@@ -0,0 +1,76 @@
+package bconf_test
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigCheckConfig(t *testing.T) {
+	if os.Getenv("BCONF_CHECK_CONFIG_TEST") != "" {
+		os.Args = []string{"testapp", "--check-config"}
+
+		appConfig := bconf.NewAppConfig("testapp", "testapp description", bconf.WithEnvironmentLoader("check"))
+
+		appConfig.AddFieldSet(bconf.FSB("api").Fields(
+			bconf.FB("port", bconf.Int).Required().C(),
+			bconf.FB("token", bconf.String).Default("secret").Sensitive().C(),
+		).C())
+
+		_ = appConfig.Load()
+
+		t.Fatal("expected load to exit the process when checking configuration")
+	}
+
+	runCheck := func(env ...string) (string, int) {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestAppConfigCheckConfig$")
+		cmd.Env = append(os.Environ(), append(env, "BCONF_CHECK_CONFIG_TEST=1")...)
+
+		output, err := cmd.Output()
+
+		exitErr := &exec.ExitError{}
+		if errors.As(err, &exitErr) {
+			return string(output), exitErr.ExitCode()
+		} else if err != nil {
+			t.Fatalf("unexpected error running configuration check: %s", err)
+		}
+
+		return string(output), 0
+	}
+
+	output, exitCode := runCheck("CHECK_API_PORT=8080")
+
+	if exitCode != 0 {
+		t.Errorf("unexpected exit code '%d' for passing configuration check, output:\n%s", exitCode, output)
+	}
+
+	for _, content := range []string{
+		"Configuration check for 'testapp' passed",
+		"api.port: '8080'",
+		"api.token: '<sensitive-value>'",
+	} {
+		if !strings.Contains(output, content) {
+			t.Errorf("expected configuration check output to contain '%s', found:\n%s", content, output)
+		}
+	}
+
+	if strings.Contains(output, "secret") {
+		t.Errorf("unexpected sensitive value in configuration check output:\n%s", output)
+	}
+
+	output, exitCode = runCheck()
+
+	if exitCode != 1 {
+		t.Errorf("unexpected exit code '%d' for failing configuration check, output:\n%s", exitCode, output)
+	}
+
+	for _, content := range []string{"Configuration check for 'testapp' failed", "Errors (1):", "api.port: <unset>"} {
+		if !strings.Contains(output, content) {
+			t.Errorf("expected configuration check output to contain '%s', found:\n%s", content, output)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package bconf
+
+import (
+	"slices"
+)
+
+// Command is an application subcommand with its own field-sets, created with the AppConfig AddCommand method.
+// Field-sets added to the AppConfig are shared by all commands, while field-sets added to a command are only loaded
+// when the command is selected. A command is selected by the first positional command-line argument, e.g.
+// 'app --log_level debug serve --api_port 8080'.
+type Command struct {
+	config      *AppConfig
+	name        string
+	description string
+}
+
+// Name returns the command name.
+func (c *Command) Name() string {
+	return c.name
+}
+
+// Description returns the command description.
+func (c *Command) Description() string {
+	return c.description
+}
+
+func (c *Command) AddFieldSetGroup(groupName string, fieldSets FieldSets) {
+	c.config.changeLock.Lock()
+	defer c.config.changeLock.Unlock()
+
+	c.config.lock.Lock()
+	defer c.config.lock.Unlock()
+
+	c.config.fieldSetGroups = append(
+		c.config.fieldSetGroups,
+		&fieldSetGroup{name: groupName, fieldSets: fieldSets, command: c.name},
+	)
+}
+
+// AddFieldSetGroups registers field-set groups that are only loaded when the command is selected.
+func (c *Command) AddFieldSetGroups(groups ...*FieldSetGroup) {
+	c.config.changeLock.Lock()
+	defer c.config.changeLock.Unlock()
+
+	c.config.lock.Lock()
+	defer c.config.lock.Unlock()
+
+	for _, group := range groups {
+		c.config.fieldSetGroups = append(c.config.fieldSetGroups, newFieldSetGroup(group, c.name))
+	}
+}
+
+func (c *Command) AddFieldSet(fieldSet *FieldSet) {
+	c.config.changeLock.Lock()
+	defer c.config.changeLock.Unlock()
+
+	c.config.lock.Lock()
+	defer c.config.lock.Unlock()
+
+	c.config.fieldSetGroups = append(
+		c.config.fieldSetGroups,
+		&fieldSetGroup{name: fieldSet.Key, fieldSets: FieldSets{fieldSet}, command: c.name, implicit: true},
+	)
+}
+
+// HelpString returns the help output for the command, including shared field-sets and the command field-sets.
+func (c *Command) HelpString() string {
+	c.config.lock.RLock()
+	defer c.config.lock.RUnlock()
+
+	return c.config.helpString(c.name)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// AddCommand registers a subcommand. Adding a command with an existing command name returns the existing command.
+func (c *AppConfig) AddCommand(name, description string) *Command {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if command := c.findCommand(name); command != nil {
+		return command
+	}
+
+	command := &Command{config: c, name: name, description: description}
+
+	c.commands = append(c.commands, command)
+
+	return command
+}
+
+// SelectedCommand returns the name of the command selected from the command-line arguments when the configuration
+// was loaded, or an empty string when no command was selected.
+func (c *AppConfig) SelectedCommand() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.selectedCommand
+}
+
+func (c *AppConfig) findCommand(name string) *Command {
+	idx := slices.IndexFunc(c.commands, func(command *Command) bool { return command.name == name })
+	if idx < 0 {
+		return nil
+	}
+
+	return c.commands[idx]
+}
+
+// selectCommand selects the command named by the first positional command-line argument, where flag values are not
+// positional arguments (e.g. 'app --name serve migrate' selects 'migrate').
+func (c *AppConfig) selectCommand() string {
+	if len(c.commands) < 1 {
+		return ""
+	}
+
+	flagLoader := c.argsFlagLoader().Clone()
+	flagLoader.SetCommand("")
+
+	positionalArgs := flagLoader.parse().positionalArgs
+	if len(positionalArgs) < 1 {
+		return ""
+	}
+
+	if command := c.findCommand(positionalArgs[0]); command != nil {
+		return command.name
+	}
+
+	return ""
+}
+
+// argsFlagLoader returns the configured flag loader, or a flag loader without registered fields when no flag loader is
+// configured, so command-line arguments are always read from the same source.
+func (c *AppConfig) argsFlagLoader() *FlagLoader {
+	if flagLoader := c.flagLoader(); flagLoader != nil {
+		return flagLoader
+	}
+
+	return NewFlagLoader()
+}
+
+// fieldSetInCommandScope checks whether a field-set is shared or belongs to the provided command.
+func (c *AppConfig) fieldSetInCommandScope(fieldSetKey, command string) bool {
+	fieldSetCommand := c.fieldSetCommands[fieldSetKey]
+
+	return fieldSetCommand == "" || fieldSetCommand == command
+}
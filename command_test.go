@@ -0,0 +1,139 @@
+package bconf_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigCommands(t *testing.T) {
+	args := os.Args
+
+	t.Cleanup(func() { os.Args = args })
+
+	newAppConfig := func() *bconf.AppConfig {
+		appConfig := bconf.NewAppConfig("testapp", "testapp description", bconf.WithFlagLoader())
+
+		appConfig.AddFieldSet(bconf.FSB("log").Fields(
+			bconf.FB("level", bconf.String).Default("info").FlagShorthand("l").C(),
+		).C())
+
+		serve := appConfig.AddCommand("serve", "serve the api")
+		serve.AddFieldSet(bconf.FSB("api").Fields(
+			bconf.FB("port", bconf.Int).Required().FlagShorthand("p").C(),
+		).C())
+
+		migrate := appConfig.AddCommand("migrate", "run database migrations")
+		migrate.AddFieldSet(bconf.FSB("migration").Fields(
+			bconf.FB("path", bconf.String).Required().FlagShorthand("p").C(),
+		).C())
+
+		return appConfig
+	}
+
+	os.Args = []string{"testapp", "--log_level", "debug", "serve", "-p", "8080"}
+
+	appConfig := newAppConfig()
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading serve command: %v", errs)
+	}
+
+	if command := appConfig.SelectedCommand(); command != "serve" {
+		t.Fatalf("unexpected selected command '%s', expected 'serve'", command)
+	}
+
+	if level, _ := appConfig.GetString("log", "level"); level != "debug" {
+		t.Errorf("unexpected shared field value '%s', expected 'debug'", level)
+	}
+
+	if port, _ := appConfig.GetInt("api", "port"); port != 8080 {
+		t.Errorf("unexpected command field value '%d', expected '8080'", port)
+	}
+
+	os.Args = []string{"testapp", "migrate", "-p", "./migrations"}
+
+	appConfig = newAppConfig()
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading migrate command: %v", errs)
+	}
+
+	if path, _ := appConfig.GetString("migration", "path"); path != "./migrations" {
+		t.Errorf("unexpected command field value '%s', expected './migrations'", path)
+	}
+
+	os.Args = []string{"testapp", "--api_port", "8080", "serve"}
+
+	if errs := newAppConfig().Load(); len(errs) != 1 {
+		t.Errorf("expected a single error for command flag before command, found: %v", errs)
+	}
+
+	os.Args = []string{"testapp", "migrate", "--migration_path", "./migrations", "--api_port", "8080"}
+
+	if errs := newAppConfig().Load(); len(errs) != 1 {
+		t.Errorf("expected a single error for flag of another command, found: %v", errs)
+	}
+
+	os.Args = []string{"testapp", "--log_level", "serve", "migrate", "-p", "./migrations"}
+
+	appConfig = newAppConfig()
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading command following a flag value: %v", errs)
+	}
+
+	if command := appConfig.SelectedCommand(); command != "migrate" {
+		t.Errorf("unexpected selected command '%s', expected 'migrate' following the flag value 'serve'", command)
+	}
+
+	os.Args = []string{"testapp"}
+
+	if errs := newAppConfig().Load(); len(errs) > 0 {
+		t.Errorf("unexpected error(s) loading without a command: %v", errs)
+	}
+}
+
+func TestAppConfigCommandHelpString(t *testing.T) {
+	args := os.Args
+	os.Args = []string{"testapp"}
+
+	t.Cleanup(func() { os.Args = args })
+
+	appConfig := bconf.NewAppConfig("testapp", "testapp description", bconf.WithFlagLoader())
+
+	appConfig.AddFieldSet(bconf.FSB("log").Fields(bconf.FB("level", bconf.String).Default("info").C()).C())
+
+	serve := appConfig.AddCommand("serve", "serve the api")
+	serve.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("port", bconf.Int).Default(8080).C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	helpString := appConfig.HelpString()
+
+	if !strings.Contains(helpString, "Commands:\n\tserve\n\t\tserve the api") {
+		t.Errorf("expected root help string to list commands, found:\n%s", helpString)
+	}
+
+	if strings.Contains(helpString, "api_port") {
+		t.Errorf("unexpected command field in root help string:\n%s", helpString)
+	}
+
+	commandHelpString := serve.HelpString()
+
+	if !strings.HasPrefix(commandHelpString, "Usage of 'testapp serve':") {
+		t.Errorf("unexpected command help string usage line:\n%s", commandHelpString)
+	}
+
+	if !strings.Contains(commandHelpString, "api_port") || !strings.Contains(commandHelpString, "log_level") {
+		t.Errorf("expected command help string to include shared and command fields, found:\n%s", commandHelpString)
+	}
+
+	if appConfig.AddCommand("serve", "") != serve {
+		t.Errorf("expected adding an existing command to return the existing command")
+	}
+}
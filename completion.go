@@ -0,0 +1,431 @@
+package bconf
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const (
+	CompletionShellBash = "bash"
+	CompletionShellZsh  = "zsh"
+	CompletionShellFish = "fish"
+
+	generateCompletionFlag = "generate-completion"
+)
+
+// CompletionShells lists the shells supported by the AppConfig CompletionScript method.
+var CompletionShells = []string{CompletionShellBash, CompletionShellZsh, CompletionShellFish}
+
+// CompletionScript generates a 'bash', 'zsh', or 'fish' completion script for the application commands, flags, and
+// enumeration values, also available through the reserved '--generate-completion <shell>' flag.
+func (c *AppConfig) CompletionScript(shell string) (string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.completionScript(shell)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type completionFlag struct {
+	name        string
+	shorthand   string
+	description string
+	command     string
+	values      []string
+	boolean     bool
+}
+
+func (c *AppConfig) completionScript(shell string) (string, error) {
+	flagLoader := c.flagLoader()
+	if flagLoader == nil {
+		return "", fmt.Errorf("problem generating %s completion script: no flag loader configured", shell)
+	}
+
+	// completions are registered for the executable name, as the app name is a display name
+	executableName := filepath.Base(os.Args[0])
+	flags := c.completionFlags(flagLoader)
+
+	switch shell {
+	case CompletionShellBash:
+		return c.bashCompletionScript(executableName, flags), nil
+	case CompletionShellZsh:
+		return c.zshCompletionScript(executableName, flags), nil
+	case CompletionShellFish:
+		return c.fishCompletionScript(executableName, flags), nil
+	default:
+		return "", fmt.Errorf(
+			"problem generating completion script: unsupported shell '%s', expected one of %v", shell, CompletionShells,
+		)
+	}
+}
+
+func (c *AppConfig) flagLoader() *FlagLoader {
+	for _, loader := range c.loaders {
+		if flagLoader, ok := loader.(*FlagLoader); ok {
+			return flagLoader
+		}
+	}
+
+	return nil
+}
+
+// completionFlags returns the reserved flags followed by the flags of every field that may be set by the flag loader.
+func (c *AppConfig) completionFlags(flagLoader *FlagLoader) []completionFlag {
+	flags := []completionFlag{
+		{name: "help", shorthand: "h", description: "Show help output", boolean: true},
+		{name: generateCompletionFlag, description: "Generate a shell completion script", values: CompletionShells},
+		{name: generateDocsFlag, description: "Generate configuration reference documentation", values: DocsFormats},
+		{name: checkConfigFlag, description: "Check the configuration and print a report", boolean: true},
+	}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		for _, fieldKey := range slices.Sorted(slices.Values(fieldSet.fieldKeys())) {
+			field := fieldSet.fieldMap[fieldKey]
+
+			if !field.loaderAllowed(flagLoader.Name()) {
+				continue
+			}
+
+			flag := completionFlag{
+				name:        flagLoader.flagKey(fmt.Sprintf("%s_%s", fieldSet.Key, field.Key)),
+				shorthand:   field.FlagShorthand,
+				description: field.Description,
+				command:     c.fieldSetCommands[fieldSet.Key],
+				boolean:     field.Type == Bool,
+			}
+
+			for _, value := range field.Enumeration {
+				flag.values = append(flag.values, fmt.Sprintf("%v", value))
+			}
+
+			flags = append(flags, flag)
+		}
+	}
+
+	return flags
+}
+
+func (c *AppConfig) commandNames() []string {
+	names := make([]string, len(c.commands))
+
+	for idx, command := range c.commands {
+		names[idx] = command.name
+	}
+
+	return names
+}
+
+func (c *AppConfig) bashCompletionScript(executableName string, flags []completionFlag) string {
+	functionName := completionFunctionName(executableName)
+	builder := strings.Builder{}
+
+	builder.WriteString(fmt.Sprintf("# bash completion for %s\n\n", completionComment(executableName)))
+	builder.WriteString(fmt.Sprintf("%s() {\n", functionName))
+	builder.WriteString("\tlocal cur prev command word words\n")
+	builder.WriteString("\tcur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	builder.WriteString("\tprev=\"${COMP_WORDS[COMP_CWORD-1]}\"\n")
+	builder.WriteString("\tcommand=\"\"\n\n")
+
+	c.writeShellCommandDetection(&builder, "\"${COMP_WORDS[@]:1:COMP_CWORD-1}\"")
+
+	builder.WriteString("\tcase \"${command}:${prev}\" in\n")
+
+	for _, flag := range flags {
+		if flag.boolean {
+			continue
+		}
+
+		builder.WriteString(fmt.Sprintf("\t\t%s)\n", completionFlagPattern(flag)))
+
+		if len(flag.values) > 0 {
+			builder.WriteString(fmt.Sprintf(
+				"\t\t\tCOMPREPLY=( $(compgen -W %s -- \"${cur}\") )\n", shellQuote(strings.Join(flag.values, " ")),
+			))
+		} else {
+			builder.WriteString("\t\t\tCOMPREPLY=()\n")
+		}
+
+		builder.WriteString("\t\t\treturn 0\n\t\t\t;;\n")
+	}
+
+	builder.WriteString("\tesac\n\n")
+	builder.WriteString(fmt.Sprintf("\twords=%s\n", shellQuote(strings.Join(completionFlagWords(flags, ""), " "))))
+
+	for _, command := range c.commands {
+		if commandWords := completionFlagWords(flags, command.name); len(commandWords) > 0 {
+			builder.WriteString(fmt.Sprintf(
+				"\t[[ \"${command}\" == %s ]] && words=\"${words} \"%s\n",
+				shellQuote(command.name),
+				shellQuote(strings.Join(commandWords, " ")),
+			))
+		}
+	}
+
+	if len(c.commands) > 0 {
+		builder.WriteString(fmt.Sprintf(
+			"\t[[ -z \"${command}\" ]] && words=\"${words} \"%s\n", shellQuote(strings.Join(c.commandNames(), " ")),
+		))
+	}
+
+	builder.WriteString("\tCOMPREPLY=( $(compgen -W \"${words}\" -- \"${cur}\") )\n")
+	builder.WriteString("}\n\n")
+	builder.WriteString(fmt.Sprintf("complete -o default -F %s %s\n", functionName, shellWord(executableName)))
+
+	return builder.String()
+}
+
+func (c *AppConfig) zshCompletionScript(executableName string, flags []completionFlag) string {
+	functionName := completionFunctionName(executableName)
+	builder := strings.Builder{}
+
+	// the '#compdef' line is read without shell quoting, so executable names requiring quotes are only registered
+	// when the script is sourced
+	if shellWord(executableName) == executableName {
+		builder.WriteString(fmt.Sprintf("#compdef %s\n\n", executableName))
+	} else {
+		builder.WriteString(fmt.Sprintf("# zsh completion for %s\n\n", completionComment(executableName)))
+	}
+	builder.WriteString(fmt.Sprintf("%s() {\n", functionName))
+	builder.WriteString("\tlocal command word\n")
+	builder.WriteString("\tlocal -a words_\n\n")
+
+	c.writeShellCommandDetection(&builder, "\"${words[@]:1:CURRENT-2}\"")
+
+	builder.WriteString("\tcase \"${command}:${words[CURRENT-1]}\" in\n")
+
+	for _, flag := range flags {
+		if flag.boolean {
+			continue
+		}
+
+		builder.WriteString(fmt.Sprintf("\t\t%s)\n", completionFlagPattern(flag)))
+
+		if len(flag.values) > 0 {
+			builder.WriteString(fmt.Sprintf("\t\t\tcompadd -- %s\n", shellQuoteWords(flag.values)))
+		} else {
+			builder.WriteString("\t\t\t_files\n")
+		}
+
+		builder.WriteString("\t\t\treturn\n\t\t\t;;\n")
+	}
+
+	builder.WriteString("\tesac\n\n")
+	builder.WriteString(fmt.Sprintf("\twords_=(%s)\n", zshDescribedWords(flags, "")))
+
+	for _, command := range c.commands {
+		commandWords := zshDescribedWords(flags, command.name)
+		if commandWords == "" {
+			continue
+		}
+
+		builder.WriteString(fmt.Sprintf(
+			"\t[[ \"${command}\" == %s ]] && words_+=(%s)\n", shellQuote(command.name), commandWords,
+		))
+	}
+
+	if len(c.commands) > 0 {
+		commandWords := make([]string, len(c.commands))
+
+		for idx, command := range c.commands {
+			commandWords[idx] = zshDescribedWord(command.name, command.description)
+		}
+
+		builder.WriteString(fmt.Sprintf(
+			"\t[[ -z \"${command}\" ]] && words_+=(%s)\n", strings.Join(commandWords, " "),
+		))
+	}
+
+	builder.WriteString("\t_describe 'option' words_\n")
+	builder.WriteString("}\n\n")
+	builder.WriteString(fmt.Sprintf("if [ \"$funcstack[1]\" = \"%s\" ]; then\n", functionName))
+	builder.WriteString(fmt.Sprintf("\t%s \"$@\"\n", functionName))
+	builder.WriteString("else\n")
+	builder.WriteString(fmt.Sprintf("\tcompdef %s %s\n", functionName, shellWord(executableName)))
+	builder.WriteString("fi\n")
+
+	return builder.String()
+}
+
+func (c *AppConfig) fishCompletionScript(executableName string, flags []completionFlag) string {
+	builder := strings.Builder{}
+	commandNames := strings.Join(c.commandNames(), " ")
+	appName := shellWord(executableName)
+
+	builder.WriteString(fmt.Sprintf("# fish completion for %s\n\n", completionComment(executableName)))
+
+	for _, command := range c.commands {
+		builder.WriteString(fmt.Sprintf(
+			"complete -c %s -f -n %s -a %s",
+			appName,
+			shellQuote("not __fish_seen_subcommand_from "+commandNames),
+			shellQuote(command.name),
+		))
+
+		if command.description != "" {
+			builder.WriteString(fmt.Sprintf(" -d %s", shellQuote(command.description)))
+		}
+
+		builder.WriteString("\n")
+	}
+
+	for _, flag := range flags {
+		condition := ""
+		if flag.command != "" {
+			condition = fmt.Sprintf(" -n %s", shellQuote("__fish_seen_subcommand_from "+flag.command))
+		}
+
+		line := fmt.Sprintf("complete -c %s%s -l %s", appName, condition, flag.name)
+
+		if flag.shorthand != "" {
+			line += fmt.Sprintf(" -s %s", flag.shorthand)
+		}
+
+		switch {
+		case len(flag.values) > 0:
+			line += fmt.Sprintf(" -x -a %s", shellQuote(strings.Join(flag.values, " ")))
+		case !flag.boolean:
+			line += " -r"
+		}
+
+		if flag.description != "" {
+			line += fmt.Sprintf(" -d %s", shellQuote(flag.description))
+		}
+
+		builder.WriteString(line + "\n")
+
+		if flag.boolean && !isReservedFlag(flag.name) {
+			builder.WriteString(fmt.Sprintf("complete -c %s%s -l no-%s\n", appName, condition, flag.name))
+		}
+	}
+
+	return builder.String()
+}
+
+// writeShellCommandDetection writes a loop setting the 'command' variable to the first command name found in the
+// provided words. The loop is valid in both bash and zsh.
+func (c *AppConfig) writeShellCommandDetection(builder *strings.Builder, words string) {
+	if len(c.commands) < 1 {
+		return
+	}
+
+	builder.WriteString(fmt.Sprintf("\tfor word in %s; do\n", words))
+	builder.WriteString("\t\tcase \"${word}\" in\n")
+	builder.WriteString(fmt.Sprintf("\t\t\t%s)\n", strings.Join(c.commandNames(), "|")))
+	builder.WriteString("\t\t\t\tcommand=\"${word}\"\n")
+	builder.WriteString("\t\t\t\tbreak\n")
+	builder.WriteString("\t\t\t\t;;\n")
+	builder.WriteString("\t\tesac\n")
+	builder.WriteString("\tdone\n\n")
+}
+
+// completionFlagPattern returns a shell case pattern matching the flag (and its shorthand) within its command scope.
+func completionFlagPattern(flag completionFlag) string {
+	command := "*"
+	if flag.command != "" {
+		command = flag.command
+	}
+
+	pattern := fmt.Sprintf("%s:--%s", command, flag.name)
+
+	if flag.shorthand != "" {
+		pattern += fmt.Sprintf("|%s:-%s", command, flag.shorthand)
+	}
+
+	return pattern
+}
+
+// completionFlagWords returns the flag names (including '--no-' boolean flags) of the provided command scope.
+func completionFlagWords(flags []completionFlag, command string) []string {
+	words := []string{}
+
+	for _, flag := range flags {
+		if flag.command != command {
+			continue
+		}
+
+		words = append(words, "--"+flag.name)
+
+		if flag.boolean && !isReservedFlag(flag.name) {
+			words = append(words, "--no-"+flag.name)
+		}
+	}
+
+	return words
+}
+
+func zshDescribedWords(flags []completionFlag, command string) string {
+	words := []string{}
+
+	for _, flag := range flags {
+		if flag.command != command {
+			continue
+		}
+
+		words = append(words, zshDescribedWord("--"+flag.name, flag.description))
+
+		if flag.boolean && !isReservedFlag(flag.name) {
+			words = append(words, zshDescribedWord("--no-"+flag.name, flag.description))
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// zshDescribedWord formats a '_describe' entry, escaping colons in the completion word.
+func zshDescribedWord(word, description string) string {
+	word = strings.ReplaceAll(word, ":", "\\:")
+
+	if description == "" {
+		return shellQuote(word)
+	}
+
+	return shellQuote(fmt.Sprintf("%s:%s", word, strings.Join(strings.Fields(description), " ")))
+}
+
+// completionFunctionName returns a shell function name derived from the executable name, replacing characters that
+// are not letters or digits.
+func completionFunctionName(executableName string) string {
+	return "_" + strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+
+		return '_'
+	}, executableName) + "_completion"
+}
+
+// completionComment returns a value on a single line, for use in script comments.
+func completionComment(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// shellWord returns values made of letters, digits, and '._+-' characters as-is, and shell quotes other values.
+func shellWord(value string) string {
+	if value != "" && strings.IndexFunc(value, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && !strings.ContainsRune("._+-", r)
+	}) < 0 {
+		return value
+	}
+
+	return shellQuote(value)
+}
+
+// shellQuote single-quotes a value, closing the quotes around embedded single quotes in a form supported by bash, zsh,
+// and fish.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "'\"'\"'") + "'"
+}
+
+func shellQuoteWords(values []string) string {
+	quoted := make([]string, len(values))
+
+	for idx, value := range values {
+		quoted[idx] = shellQuote(value)
+	}
+
+	return strings.Join(quoted, " ")
+}
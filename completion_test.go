@@ -0,0 +1,137 @@
+package bconf_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigCompletionScript(t *testing.T) {
+	args := os.Args
+	os.Args = []string{"testapp"}
+
+	t.Cleanup(func() { os.Args = args })
+
+	appConfig := bconf.NewAppConfig("testapp", "testapp description", bconf.WithFlagLoader())
+
+	appConfig.AddFieldSet(bconf.FSB("log").Fields(
+		bconf.FB("level", bconf.String).Default("info").Enumeration("debug", "info").FlagShorthand("l").C(),
+		bconf.FB("color", bconf.Bool).Description("colorize 'log' output").C(),
+	).C())
+
+	serve := appConfig.AddCommand("serve", "serve the api")
+	serve.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("port", bconf.Int).Default(8080).C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	expectedContent := map[string][]string{
+		bconf.CompletionShellBash: {
+			"*:--log_level|*:-l)",
+			"compgen -W 'debug info'",
+			"--log_color --no-log_color",
+			"serve:--api_port)",
+			"complete -o default -F _testapp_completion testapp",
+		},
+		bconf.CompletionShellZsh: {
+			"#compdef testapp",
+			"compadd -- 'debug' 'info'",
+			`'--log_color:colorize '"'"'log'"'"' output'`,
+			"'serve:serve the api'",
+		},
+		bconf.CompletionShellFish: {
+			"complete -c testapp -l log_level -s l -x -a 'debug info'",
+			"complete -c testapp -l no-log_color",
+			"complete -c testapp -n '__fish_seen_subcommand_from serve' -l api_port -r",
+		},
+	}
+
+	for shell, contents := range expectedContent {
+		script, err := appConfig.CompletionScript(shell)
+		if err != nil {
+			t.Fatalf("unexpected error generating %s completion script: %s", shell, err)
+		}
+
+		for _, content := range contents {
+			if !strings.Contains(script, content) {
+				t.Errorf("expected %s completion script to contain '%s', found:\n%s", shell, content, script)
+			}
+		}
+
+		if shellPath, err := exec.LookPath(shell); err == nil {
+			scriptPath := filepath.Join(t.TempDir(), "completion."+shell)
+
+			if err := os.WriteFile(scriptPath, []byte(script), 0o600); err != nil {
+				t.Fatalf("unexpected error writing %s completion script: %s", shell, err)
+			}
+
+			if output, err := exec.Command(shellPath, "-n", scriptPath).CombinedOutput(); err != nil {
+				t.Errorf("invalid %s completion script syntax: %s", shell, output)
+			}
+		}
+	}
+
+	if _, err := appConfig.CompletionScript("powershell"); err == nil {
+		t.Errorf("expected error generating completion script for unsupported shell")
+	}
+
+	appConfig = bconf.NewAppConfig("testapp", "testapp description", bconf.WithEnvironmentLoader(""))
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if _, err := appConfig.CompletionScript(bconf.CompletionShellBash); err == nil {
+		t.Errorf("expected error generating completion script without a flag loader")
+	}
+}
+
+func TestAppConfigCompletionScriptExecutableName(t *testing.T) {
+	args := os.Args
+	os.Args = []string{"/opt/bin/my app;touch x"}
+
+	t.Cleanup(func() { os.Args = args })
+
+	appConfig := bconf.NewAppConfig("Auth Service", "auth service description", bconf.WithFlagLoader())
+
+	appConfig.AddFieldSet(bconf.FSB("log").Fields(bconf.FB("level", bconf.String).Default("info").C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	expectedContent := map[string]string{
+		bconf.CompletionShellBash: "complete -o default -F _my_app_touch_x_completion 'my app;touch x'\n",
+		bconf.CompletionShellZsh:  "compdef _my_app_touch_x_completion 'my app;touch x'\n",
+		bconf.CompletionShellFish: "complete -c 'my app;touch x' -l log_level -r\n",
+	}
+
+	for shell, content := range expectedContent {
+		script, err := appConfig.CompletionScript(shell)
+		if err != nil {
+			t.Fatalf("unexpected error generating %s completion script: %s", shell, err)
+		}
+
+		if !strings.Contains(script, content) || strings.Contains(script, "Auth Service") ||
+			strings.Contains(script, "#compdef") {
+			t.Errorf("expected %s completion script for the quoted executable name, found:\n%s", shell, script)
+		}
+
+		if shellPath, err := exec.LookPath(shell); err == nil {
+			scriptPath := filepath.Join(t.TempDir(), "completion."+shell)
+
+			if err := os.WriteFile(scriptPath, []byte(script), 0o600); err != nil {
+				t.Fatalf("unexpected error writing %s completion script: %s", shell, err)
+			}
+
+			if output, err := exec.Command(shellPath, "-n", scriptPath).CombinedOutput(); err != nil {
+				t.Errorf("invalid %s completion script syntax: %s", shell, output)
+			}
+		}
+	}
+}
@@ -1,5 +1,10 @@
 package bconf
 
+import (
+	"log/slog"
+	"slices"
+)
+
 const (
 	configOptionTypeLoaderEnvironment = "loader_environment"
 	configOptionTypeLoaderFlag        = "loader_flag"
@@ -8,11 +13,19 @@ const (
 	configOptionTypeAppVersion        = "app_version"
 	configOptionTypeAppIDFunc         = "app_id_func"
 	configOptionTypeAppID             = "app_id"
+	configOptionTypeAppProfile        = "app_profile"
+	configOptionTypeLoaderPrecedence  = "loader_precedence"
+	configOptionTypeHelpOptions       = "help_options"
+	configOptionTypeLogger            = "logger"
+	configOptionTypeHooks             = "hooks"
 )
 
 type JSONLoaderConfigOption interface {
 	ConfigOption
 	WithDecoder(decoder JSONUnmarshal)
+	// WithOptionalFiles marks file paths as not required to exist. Paths already given to WithJSONFileLoader keep their
+	// precedence, and other paths are added after them.
+	WithOptionalFiles(filePaths ...string)
 }
 
 type ConfigOption interface {
@@ -45,6 +58,9 @@ func WithFlagLoader(keyPrefix ...string) ConfigOption {
 	}
 }
 
+// WithJSONFileLoader enables the JSON file loader, where earlier file paths take precedence over later file paths.
+// Files that are missing or cannot be decoded are returned as Load errors, unless marked with WithOptionalFiles (e.g.
+// WithJSONFileLoader("local.json", "config.json").WithOptionalFiles("local.json") for an optional override file).
 func WithJSONFileLoader(filePaths ...string) JSONLoaderConfigOption {
 	return &configOptionJSONFileLoader{filePaths: filePaths}
 }
@@ -65,6 +81,37 @@ func WithAppVersionFunc(appVersionFunc func() string) ConfigOption {
 	return configOptionAppVersionFunc{versionFunc: appVersionFunc}
 }
 
+// WithAppProfile sets the default application profile. The active profile can be overridden through the 'app.profile'
+// field (e.g. the 'APP_PROFILE' environment variable or the '--app_profile' flag), and selects field profile defaults
+// and profile-specific loader sources.
+func WithAppProfile(profile string) ConfigOption {
+	return configOptionAppProfile{profile: profile}
+}
+
+// WithHelpOptions configures the help output renderer, writer, width, colors, field grouping, and hidden fields.
+func WithHelpOptions(options HelpOptions) ConfigOption {
+	return configOptionHelpOptions{options: options}
+}
+
+// WithLogger sets a structured logger used to log load events, warnings, field overrides, reloads, and the use of
+// deprecated fields. Warnings are still collected and returned by AppConfig.Warnings.
+func WithLogger(logger *slog.Logger) ConfigOption {
+	return configOptionLogger{logger: logger}
+}
+
+// WithHooks registers load lifecycle hooks. The option may be provided multiple times, where hooks run in the order
+// they are registered.
+func WithHooks(hooks Hooks) ConfigOption {
+	return configOptionHooks{hooks: hooks}
+}
+
+// WithLoaderPrecedence sets the order in which loaders are applied, listing loader names from lowest to highest
+// priority. Loaders that are not listed keep their relative order and take a lower priority than listed loaders. By
+// default, loader precedence follows the order loader options are passed to NewAppConfig.
+func WithLoaderPrecedence(loaderNames ...string) ConfigOption {
+	return configOptionLoaderPrecedence{loaderNames: loaderNames}
+}
+
 type configOptionEnvironmentLoader struct {
 	keyPrefix string
 }
@@ -90,20 +137,36 @@ func (o configOptionFlagLoader) Loader() Loader {
 }
 
 type configOptionJSONFileLoader struct {
-	decoder   JSONUnmarshal
-	filePaths []string
+	decoder           JSONUnmarshal
+	filePaths         []string
+	optionalFilePaths []string
 }
 
 func (o *configOptionJSONFileLoader) WithDecoder(decoder JSONUnmarshal) {
 	o.decoder = decoder
 }
 
+func (o *configOptionJSONFileLoader) WithOptionalFiles(filePaths ...string) {
+	o.optionalFilePaths = append(o.optionalFilePaths, filePaths...)
+}
+
 func (o *configOptionJSONFileLoader) ConfigOptionType() string {
 	return configOptionTypeLoaderJSONFile
 }
 
 func (o configOptionJSONFileLoader) Loader() Loader {
-	return NewJSONFileLoaderWithAttributes(o.decoder, o.filePaths...)
+	filePaths := slices.Clone(o.filePaths)
+
+	for _, filePath := range o.optionalFilePaths {
+		if !slices.Contains(filePaths, filePath) {
+			filePaths = append(filePaths, filePath)
+		}
+	}
+
+	loader := NewJSONFileLoaderWithAttributes(o.decoder, filePaths...)
+	loader.OptionalFilePaths = slices.Clone(o.optionalFilePaths)
+
+	return loader
 }
 
 type configOptionAppVersion struct {
@@ -137,3 +200,43 @@ type configOptionAppIDFunc struct {
 func (o configOptionAppIDFunc) ConfigOptionType() string {
 	return configOptionTypeAppIDFunc
 }
+
+type configOptionAppProfile struct {
+	profile string
+}
+
+func (o configOptionAppProfile) ConfigOptionType() string {
+	return configOptionTypeAppProfile
+}
+
+type configOptionLoaderPrecedence struct {
+	loaderNames []string
+}
+
+func (o configOptionLoaderPrecedence) ConfigOptionType() string {
+	return configOptionTypeLoaderPrecedence
+}
+
+type configOptionHelpOptions struct {
+	options HelpOptions
+}
+
+func (o configOptionHelpOptions) ConfigOptionType() string {
+	return configOptionTypeHelpOptions
+}
+
+type configOptionLogger struct {
+	logger *slog.Logger
+}
+
+func (o configOptionLogger) ConfigOptionType() string {
+	return configOptionTypeLogger
+}
+
+type configOptionHooks struct {
+	hooks Hooks
+}
+
+func (o configOptionHooks) ConfigOptionType() string {
+	return configOptionTypeHooks
+}
@@ -0,0 +1,156 @@
+package bconf
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// FieldChange describes a field value that changed when a field-set or field was reloaded. A nil value indicates that
+// the field value was unset.
+type FieldChange struct {
+	PreviousValue any
+	Value         any
+	FieldSetKey   string
+	FieldKey      string
+	Sensitive     bool
+}
+
+type FieldChanges []FieldChange
+
+// ReloadFieldSet re-queries the config loaders for all values in a field-set. Previously loaded values are reset,
+// load conditions are re-evaluated, values are re-validated, and attached config structs are re-filled. Override
+// values set with SetField are kept. If reloading fails, including when a loader reports problems with its source
+// (e.g. a JSON file that has become malformed or gone missing), the field-set is left unchanged and the errors are
+// returned.
+// Field-sets with load conditions that depend on the reloaded field-set are not reloaded.
+func (c *AppConfig) ReloadFieldSet(fieldSetKey string) (FieldChanges, []error) {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
+	staged := c.stage()
+	changes, errs := staged.reload(fieldSetKey)
+
+	c.commit(staged)
+
+	c.logReload(fieldSetKey, "", changes, errs)
+
+	return changes, errs
+}
+
+// ReloadField re-queries the config loaders for a single field value, following the same behavior as ReloadFieldSet.
+func (c *AppConfig) ReloadField(fieldSetKey, fieldKey string) (FieldChanges, []error) {
+	c.changeLock.Lock()
+	defer c.changeLock.Unlock()
+
+	if _, err := c.findField(fieldSetKey, fieldKey); err != nil {
+		return nil, []error{err}
+	}
+
+	staged := c.stage()
+	changes, errs := staged.reload(fieldSetKey, fieldKey)
+
+	c.commit(staged)
+
+	c.logReload(fieldSetKey, fieldKey, changes, errs)
+
+	return changes, errs
+}
+
+func (c *AppConfig) reload(fieldSetKey string, fieldKeys ...string) (FieldChanges, []error) {
+	if !c.loaded {
+		return nil, []error{errors.New("configuration must be loaded before reloading")}
+	}
+
+	fieldSet, found := c.fieldSets[fieldSetKey]
+	if !found {
+		return nil, []error{fmt.Errorf("field-set with key '%s' not found", fieldSetKey)}
+	}
+
+	previous := fieldSet.Clone()
+
+	defer c.prepareLoaders()()
+
+	if errs := c.loaderErrors(); len(errs) > 0 {
+		return nil, errs
+	}
+
+	if errs := c.loadFieldSet(fieldSetKey, fieldKeys...); len(errs) > 0 {
+		c.replaceFieldSet(previous)
+
+		return nil, errs
+	}
+
+	if fieldSetKey == "app" {
+		c.activateProfile()
+	}
+
+	fillErrors := []error{}
+
+	for _, fillStruct := range c.fillStructs {
+		if err := c.fillAttachedStruct(fillStruct); err != nil {
+			fillErrors = append(fillErrors, err)
+		}
+	}
+
+	if len(fillErrors) > 0 {
+		c.replaceFieldSet(previous)
+
+		if fieldSetKey == "app" {
+			c.activateProfile()
+		}
+
+		for _, fillStruct := range c.fillStructs {
+			_ = c.fillAttachedStruct(fillStruct)
+		}
+
+		return nil, fillErrors
+	}
+
+	changes := fieldSetChanges(previous, c.fieldSets[fieldSetKey])
+
+	if len(changes) > 0 {
+		c.publishSnapshot()
+	}
+
+	return changes, nil
+}
+
+// replaceFieldSet swaps a registered field-set with the provided field-set sharing the same key.
+func (c *AppConfig) replaceFieldSet(fieldSet *FieldSet) {
+	c.fieldSets[fieldSet.Key] = fieldSet
+
+	idx := slices.IndexFunc(c.orderedFieldSets, func(orderedFieldSet *FieldSet) bool {
+		return orderedFieldSet.Key == fieldSet.Key
+	})
+
+	if idx > -1 {
+		c.orderedFieldSets[idx] = fieldSet
+	}
+}
+
+func fieldSetChanges(previous, current *FieldSet) FieldChanges {
+	changes := FieldChanges{}
+
+	for _, fieldKey := range slices.Sorted(slices.Values(current.fieldKeys())) {
+		currentField := current.fieldMap[fieldKey]
+
+		previousValue, _ := previous.fieldMap[fieldKey].getValue()
+		currentValue, _ := currentField.getValue()
+
+		if reflect.DeepEqual(previousValue, currentValue) {
+			continue
+		}
+
+		changes = append(changes, FieldChange{
+			PreviousValue: previousValue,
+			Value:         currentValue,
+			FieldSetKey:   current.Key,
+			FieldKey:      fieldKey,
+			Sensitive:     currentField.Sensitive,
+		})
+	}
+
+	return changes
+}
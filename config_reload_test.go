@@ -0,0 +1,138 @@
+package bconf_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+type ReloadConfig struct {
+	bconf.ConfigStruct `bconf:"api"`
+	Host               string `bconf:"host"`
+	Port               int    `bconf:"port"`
+}
+
+func TestAppConfigReloadFieldSet(t *testing.T) {
+	t.Setenv("BCONF_RELOAD_TEST_API_HOST", "localhost")
+	t.Setenv("BCONF_RELOAD_TEST_API_PORT", "8080")
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_reload_test"),
+	)
+
+	if _, errs := appConfig.ReloadFieldSet("api"); len(errs) < 1 {
+		t.Fatalf("expected error reloading field-set before load")
+	}
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).Default("default.example.com").C(),
+		bconf.FB("port", bconf.Int).C(),
+	).C())
+
+	reloadConfig := &ReloadConfig{}
+	appConfig.AttachConfigStructs(reloadConfig)
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	generation := appConfig.Snapshot().Generation()
+
+	t.Setenv("BCONF_RELOAD_TEST_API_PORT", "9090")
+
+	changes, errs := appConfig.ReloadFieldSet("api")
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error(s) reloading field-set: %v", errs)
+	}
+
+	if len(changes) != 1 || changes[0].FieldKey != "port" || changes[0].PreviousValue != 8080 ||
+		changes[0].Value != 9090 {
+		t.Fatalf("unexpected field-set reload changes: %+v", changes)
+	}
+
+	if reloadConfig.Port != 9090 {
+		t.Errorf("unexpected attached config struct port '%d', expected 9090", reloadConfig.Port)
+	}
+
+	if port, _ := appConfig.Snapshot().GetInt("api", "port"); port != 9090 {
+		t.Errorf("unexpected snapshot port '%d', expected 9090", port)
+	}
+
+	if appConfig.Snapshot().Generation() <= generation {
+		t.Errorf("expected a new snapshot generation after reloading changed values")
+	}
+
+	if err := os.Unsetenv("BCONF_RELOAD_TEST_API_HOST"); err != nil {
+		t.Fatalf("unexpected error unsetting environment variable: %s", err)
+	}
+
+	changes, errs = appConfig.ReloadField("api", "host")
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error(s) reloading field: %v", errs)
+	}
+
+	if len(changes) != 1 || changes[0].Value != "default.example.com" {
+		t.Fatalf("unexpected field reload changes: %+v", changes)
+	}
+
+	t.Setenv("BCONF_RELOAD_TEST_API_PORT", "not-a-port")
+
+	if _, errs = appConfig.ReloadFieldSet("api"); len(errs) < 1 {
+		t.Fatalf("expected error reloading field-set with invalid value")
+	}
+
+	if port, _ := appConfig.GetInt("api", "port"); port != 9090 {
+		t.Errorf("unexpected port '%d' after failed reload, expected previous value 9090", port)
+	}
+
+	if _, errs = appConfig.ReloadField("api", "unknown"); len(errs) < 1 {
+		t.Errorf("expected error reloading unknown field")
+	}
+
+	if _, errs = appConfig.ReloadFieldSet("unknown"); len(errs) < 1 {
+		t.Errorf("expected error reloading unknown field-set")
+	}
+}
+
+func TestAppConfigReloadFieldSetLoaderErrors(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configPath, []byte(`{"api": {"host": "api.example.com"}}`), 0o600); err != nil {
+		t.Fatalf("unexpected error writing config file: %s", err)
+	}
+
+	appConfig := bconf.NewAppConfig("testapp", "testapp description", bconf.WithJSONFileLoader(configPath))
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("host", bconf.String).Default("localhost").C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if err := os.WriteFile(configPath, []byte(`{"api": {"host": `), 0o600); err != nil {
+		t.Fatalf("unexpected error corrupting config file: %s", err)
+	}
+
+	if _, errs := appConfig.ReloadFieldSet("api"); len(errs) < 1 {
+		t.Fatal("expected error reloading field-set from a malformed JSON file")
+	}
+
+	if host, _ := appConfig.GetString("api", "host"); host != "api.example.com" {
+		t.Errorf("unexpected host '%s' after failed reload, expected previous value 'api.example.com'", host)
+	}
+
+	if err := os.Remove(configPath); err != nil {
+		t.Fatalf("unexpected error removing config file: %s", err)
+	}
+
+	if _, errs := appConfig.ReloadField("api", "host"); len(errs) < 1 {
+		t.Fatal("expected error reloading field from a missing JSON file")
+	}
+
+	if host, _ := appConfig.GetString("api", "host"); host != "api.example.com" {
+		t.Errorf("unexpected host '%s' after failed reload, expected previous value 'api.example.com'", host)
+	}
+}
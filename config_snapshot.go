@@ -0,0 +1,204 @@
+package bconf
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ConfigSnapshot is an immutable view of AppConfig configuration values at a point in time. Snapshots are safe for
+// concurrent use, and are replaced (never modified) by the AppConfig when configuration values change.
+type ConfigSnapshot struct {
+	fieldSets  map[string]map[string]snapshotField
+	generation uint64
+}
+
+type snapshotField struct {
+	value     any
+	fieldType string
+	set       bool
+}
+
+// Generation returns the snapshot generation, which increases each time the AppConfig publishes a new snapshot.
+func (s *ConfigSnapshot) Generation() uint64 {
+	return s.generation
+}
+
+func (s *ConfigSnapshot) GetString(fieldSetKey, fieldKey string) (string, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, String)
+	if err != nil {
+		return "", err
+	}
+
+	val, _ := fieldValue.(string)
+
+	return val, nil
+}
+
+func (s *ConfigSnapshot) GetStrings(fieldSetKey, fieldKey string) ([]string, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Strings)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ := fieldValue.([]string)
+
+	return slices.Clone(val), nil
+}
+
+func (s *ConfigSnapshot) GetInt(fieldSetKey, fieldKey string) (int, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Int)
+	if err != nil {
+		return 0, err
+	}
+
+	val, _ := fieldValue.(int)
+
+	return val, nil
+}
+
+func (s *ConfigSnapshot) GetInts(fieldSetKey, fieldKey string) ([]int, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Ints)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ := fieldValue.([]int)
+
+	return slices.Clone(val), nil
+}
+
+func (s *ConfigSnapshot) GetBool(fieldSetKey, fieldKey string) (bool, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Bool)
+	if err != nil {
+		return false, err
+	}
+
+	val, _ := fieldValue.(bool)
+
+	return val, nil
+}
+
+func (s *ConfigSnapshot) GetBools(fieldSetKey, fieldKey string) ([]bool, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Bools)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ := fieldValue.([]bool)
+
+	return slices.Clone(val), nil
+}
+
+func (s *ConfigSnapshot) GetTime(fieldSetKey, fieldKey string) (time.Time, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Time)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	val, _ := fieldValue.(time.Time)
+
+	return val, nil
+}
+
+func (s *ConfigSnapshot) GetTimes(fieldSetKey, fieldKey string) ([]time.Time, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Times)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ := fieldValue.([]time.Time)
+
+	return slices.Clone(val), nil
+}
+
+func (s *ConfigSnapshot) GetDuration(fieldSetKey, fieldKey string) (time.Duration, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Duration)
+	if err != nil {
+		return 0, err
+	}
+
+	val, _ := fieldValue.(time.Duration)
+
+	return val, nil
+}
+
+func (s *ConfigSnapshot) GetDurations(fieldSetKey, fieldKey string) ([]time.Duration, error) {
+	fieldValue, err := s.getFieldValue(fieldSetKey, fieldKey, Durations)
+	if err != nil {
+		return nil, err
+	}
+
+	val, _ := fieldValue.([]time.Duration)
+
+	return slices.Clone(val), nil
+}
+
+func (s *ConfigSnapshot) getFieldValue(fieldSetKey, fieldKey, expectedType string) (any, error) {
+	fieldSet, found := s.fieldSets[fieldSetKey]
+	if !found {
+		return nil, fmt.Errorf("field-set not found with key '%s'", fieldSetKey)
+	}
+
+	field, found := fieldSet[fieldKey]
+	if !found {
+		return nil, fmt.Errorf("field-set field not found with key '%s'", fieldKey)
+	}
+
+	if field.fieldType != expectedType {
+		return nil, fmt.Errorf("incorrect field-type for field '%s', found '%s'", fieldKey, field.fieldType)
+	}
+
+	if !field.set {
+		return nil, fmt.Errorf("no value set for field '%s'", fieldKey)
+	}
+
+	return field.value, nil
+}
+
+// newConfigSnapshot creates a snapshot of the current field-set values, copying list values so that the snapshot
+// does not share memory with the field-sets.
+func newConfigSnapshot(fieldSets map[string]*FieldSet, generation uint64) *ConfigSnapshot {
+	snapshot := &ConfigSnapshot{
+		fieldSets:  make(map[string]map[string]snapshotField, len(fieldSets)),
+		generation: generation,
+	}
+
+	for fieldSetKey, fieldSet := range fieldSets {
+		fields := make(map[string]snapshotField, len(fieldSet.fieldMap))
+
+		for fieldKey, field := range fieldSet.fieldMap {
+			entry := snapshotField{fieldType: field.Type}
+
+			if value, err := field.getValue(); err == nil {
+				entry.value = cloneFieldValue(value)
+				entry.set = true
+			}
+
+			fields[fieldKey] = entry
+		}
+
+		snapshot.fieldSets[fieldSetKey] = fields
+	}
+
+	return snapshot
+}
+
+func cloneFieldValue(value any) any {
+	switch typedValue := value.(type) {
+	case []string:
+		return slices.Clone(typedValue)
+	case []int:
+		return slices.Clone(typedValue)
+	case []bool:
+		return slices.Clone(typedValue)
+	case []float64:
+		return slices.Clone(typedValue)
+	case []time.Time:
+		return slices.Clone(typedValue)
+	case []time.Duration:
+		return slices.Clone(typedValue)
+	default:
+		return value
+	}
+}
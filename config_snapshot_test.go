@@ -0,0 +1,127 @@
+package bconf_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestConfigSnapshot(t *testing.T) {
+	appConfig := createBaseAppConfig()
+
+	if snapshot := appConfig.Snapshot(); snapshot.Generation() != 0 {
+		t.Fatalf("unexpected snapshot generation before load '%d', expected 0", snapshot.Generation())
+	}
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("port", bconf.Int).Default(8080).C(),
+		bconf.FB("hosts", bconf.Strings).Default([]string{"localhost"}).C(),
+		bconf.FB("read_timeout", bconf.Duration).Default(5*time.Second).C(),
+		bconf.FB("log_prefix", bconf.String).C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	snapshot := appConfig.Snapshot()
+
+	if port, err := snapshot.GetInt("api", "port"); err != nil || port != 8080 {
+		t.Fatalf("unexpected snapshot port value '%d' (err: %v), expected 8080", port, err)
+	}
+
+	if readTimeout, err := snapshot.GetDuration("api", "read_timeout"); err != nil || readTimeout != 5*time.Second {
+		t.Fatalf("unexpected snapshot read timeout value '%s' (err: %v)", readTimeout, err)
+	}
+
+	if _, err := snapshot.GetString("api", "log_prefix"); err == nil {
+		t.Errorf("expected error getting unset snapshot field value")
+	}
+
+	if _, err := snapshot.GetString("api", "port"); err == nil {
+		t.Errorf("expected error getting snapshot field value with incorrect field-type")
+	}
+
+	if _, err := snapshot.GetString("database", "host"); err == nil {
+		t.Errorf("expected error getting snapshot field value from unknown field-set")
+	}
+
+	hosts, _ := snapshot.GetStrings("api", "hosts")
+	hosts[0] = "modified"
+
+	if hosts, _ = snapshot.GetStrings("api", "hosts"); hosts[0] != "localhost" {
+		t.Errorf("unexpected snapshot modification through returned list value: %v", hosts)
+	}
+
+	if err := appConfig.SetField("api", "port", 9090); err != nil {
+		t.Fatalf("unexpected error setting field: %s", err)
+	}
+
+	if port, _ := snapshot.GetInt("api", "port"); port != 8080 {
+		t.Errorf("unexpected change to existing snapshot port value '%d', expected 8080", port)
+	}
+
+	updatedSnapshot := appConfig.Snapshot()
+
+	if updatedSnapshot.Generation() <= snapshot.Generation() {
+		t.Errorf(
+			"unexpected snapshot generation '%d', expected greater than '%d'",
+			updatedSnapshot.Generation(), snapshot.Generation(),
+		)
+	}
+
+	if port, _ := updatedSnapshot.GetInt("api", "port"); port != 9090 {
+		t.Errorf("unexpected updated snapshot port value '%d', expected 9090", port)
+	}
+}
+
+func TestConfigSnapshotConcurrentReads(t *testing.T) {
+	appConfig := createBaseAppConfig()
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("port", bconf.Int).Default(8080).C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	wg := sync.WaitGroup{}
+
+	for range 4 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			lastGeneration := uint64(0)
+
+			for range 100 {
+				snapshot := appConfig.Snapshot()
+
+				if snapshot.Generation() < lastGeneration {
+					t.Errorf("unexpected snapshot generation '%d' after '%d'", snapshot.Generation(), lastGeneration)
+					return
+				}
+
+				lastGeneration = snapshot.Generation()
+
+				port, _ := snapshot.GetInt("api", "port")
+				time.Sleep(time.Microsecond)
+
+				if samePort, _ := snapshot.GetInt("api", "port"); samePort != port {
+					t.Errorf("unexpected snapshot port change from '%d' to '%d'", port, samePort)
+					return
+				}
+			}
+		}()
+	}
+
+	for port := 8081; port < 8181; port++ {
+		if err := appConfig.SetField("api", "port", port); err != nil {
+			t.Errorf("unexpected error setting field: %s", err)
+		}
+	}
+
+	wg.Wait()
+}
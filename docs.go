@@ -0,0 +1,358 @@
+package bconf
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const (
+	DocsFormatMarkdown = "markdown"
+	DocsFormatMan      = "man"
+	DocsFormatHTML     = "html"
+
+	generateDocsFlag = "generate-docs"
+)
+
+// DocsFormats lists the formats supported by the AppConfig Docs method.
+var DocsFormats = []string{DocsFormatMarkdown, DocsFormatMan, DocsFormatHTML}
+
+// Docs renders a 'markdown', 'man' (roff), or 'html' configuration reference of the registered field-sets, also
+// available through the reserved '--generate-docs <format>' flag.
+func (c *AppConfig) Docs(format string) (string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.docs(format)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type docsFieldSet struct {
+	key     string
+	command string
+	fields  []docsField
+}
+
+type docsField struct {
+	key         string
+	description string
+	details     []docsDetail
+}
+
+type docsDetail struct {
+	label string
+	value string
+}
+
+func (c *AppConfig) docs(format string) (string, error) {
+	appName := c.appValue("name")
+	if appName == "" {
+		appName = filepath.Base(os.Args[0])
+	}
+
+	fieldSets := c.docsFieldSets()
+
+	switch format {
+	case DocsFormatMarkdown:
+		return c.markdownDocs(appName, fieldSets), nil
+	case DocsFormatMan:
+		return c.manDocs(appName, fieldSets), nil
+	case DocsFormatHTML:
+		return c.htmlDocs(appName, fieldSets), nil
+	default:
+		return "", fmt.Errorf(
+			"problem generating docs: unsupported format '%s', expected one of %v", format, DocsFormats,
+		)
+	}
+}
+
+func (c *AppConfig) docsFieldSets() []docsFieldSet {
+	fieldSets := make([]docsFieldSet, 0, len(c.orderedFieldSets))
+
+	for _, fieldSet := range c.orderedFieldSets {
+		docsSet := docsFieldSet{key: fieldSet.Key, command: c.fieldSetCommands[fieldSet.Key]}
+
+		for _, fieldKey := range slices.Sorted(slices.Values(fieldSet.fieldKeys())) {
+			field := fieldSet.fieldMap[fieldKey]
+			loadConditions := append(slices.Clone(fieldSet.LoadConditions), field.LoadConditions...)
+
+			docsSet.fields = append(docsSet.fields, docsField{
+				key:         fmt.Sprintf("%s.%s", fieldSet.Key, field.Key),
+				description: field.Description,
+				details:     c.docsFieldDetails(fieldSet.Key, field, loadConditions),
+			})
+		}
+
+		fieldSets = append(fieldSets, docsSet)
+	}
+
+	return fieldSets
+}
+
+func (c *AppConfig) docsFieldDetails(fieldSetKey string, field *Field, loadConditions LoadConditions) []docsDetail {
+	details := []docsDetail{{label: "Type", value: field.Type}}
+
+	switch {
+	case field.Required && len(loadConditions) > 0:
+		details = append(details, docsDetail{label: "Required", value: "yes, when loaded"})
+	case field.Required:
+		details = append(details, docsDetail{label: "Required", value: "yes"})
+	default:
+		details = append(details, docsDetail{label: "Required", value: "no"})
+	}
+
+	switch {
+	case field.Default != nil && field.Sensitive:
+		details = append(details, docsDetail{label: "Default value", value: "<sensitive-value>"})
+	case field.Default != nil:
+		details = append(details, docsDetail{label: "Default value", value: fmt.Sprintf("%v", field.Default)})
+	case field.DefaultGenerator != nil:
+		details = append(details, docsDetail{label: "Default value", value: "<generated-at-run-time>"})
+	}
+
+	for _, profile := range field.profiles() {
+		value := fmt.Sprintf("%v", field.ProfileDefaults[profile])
+		if field.Sensitive {
+			value = "<sensitive-value>"
+		}
+
+		details = append(details, docsDetail{label: fmt.Sprintf("Profile '%s' default value", profile), value: value})
+	}
+
+	if len(field.Enumeration) > 0 {
+		details = append(details, docsDetail{label: field.enumerationLabel(), value: field.enumerationString()})
+	}
+
+	if field.Sensitive {
+		details = append(details, docsDetail{label: "Sensitive", value: "yes"})
+	}
+
+	for _, loader := range c.loaders {
+		if !field.loaderAllowed(loader.Name()) {
+			continue
+		}
+
+		helpString := loader.HelpString(fieldSetKey, field.Key)
+		if helpString == "" {
+			continue
+		}
+
+		if label, value, found := strings.Cut(helpString, ": "); found {
+			details = append(details, docsDetail{label: label, value: value})
+		} else {
+			details = append(details, docsDetail{label: fmt.Sprintf("Loader '%s'", loader.Name()), value: helpString})
+		}
+	}
+
+	for _, condition := range loadConditions {
+		dependencies := condition.FieldDependencies()
+		if len(dependencies) < 1 {
+			details = append(details, docsDetail{label: "Loading depends on", value: "<custom-load-condition-function>"})
+
+			continue
+		}
+
+		locations := make([]string, len(dependencies))
+
+		for idx, dependency := range dependencies {
+			locations[idx] = fmt.Sprintf("'%s.%s'", dependency.FieldSetKey, dependency.FieldKey)
+		}
+
+		details = append(details, docsDetail{
+			label: "Loading depends on field(s)",
+			value: strings.Join(locations, ", "),
+		})
+	}
+
+	return details
+}
+
+func (c *AppConfig) markdownDocs(appName string, fieldSets []docsFieldSet) string {
+	builder := strings.Builder{}
+
+	builder.WriteString(fmt.Sprintf("# %s configuration reference\n\n", appName))
+
+	if description := c.appValue("description"); description != "" {
+		builder.WriteString(fmt.Sprintf("%s\n\n", description))
+	}
+
+	if len(c.commands) > 0 {
+		builder.WriteString("## Commands\n\n")
+
+		for _, command := range c.commands {
+			builder.WriteString(fmt.Sprintf("* `%s`", command.name))
+
+			if command.description != "" {
+				builder.WriteString(fmt.Sprintf(": %s", command.description))
+			}
+
+			builder.WriteString("\n")
+		}
+
+		builder.WriteString("\n")
+	}
+
+	for _, fieldSet := range fieldSets {
+		builder.WriteString(fmt.Sprintf("## Field-set `%s`\n\n", fieldSet.key))
+
+		if fieldSet.command != "" {
+			builder.WriteString(fmt.Sprintf("Loaded for command `%s`.\n\n", fieldSet.command))
+		}
+
+		for _, field := range fieldSet.fields {
+			builder.WriteString(fmt.Sprintf("### `%s`\n\n", field.key))
+
+			if field.description != "" {
+				builder.WriteString(fmt.Sprintf("%s\n\n", field.description))
+			}
+
+			for _, detail := range field.details {
+				builder.WriteString(fmt.Sprintf("* %s: `%s`\n", detail.label, detail.value))
+			}
+
+			builder.WriteString("\n")
+		}
+	}
+
+	return builder.String()
+}
+
+func (c *AppConfig) manDocs(appName string, fieldSets []docsFieldSet) string {
+	builder := strings.Builder{}
+
+	builder.WriteString(fmt.Sprintf(
+		".TH \"%s\" \"5\" \"\" \"%s\" \"%s configuration reference\"\n",
+		roffEscape(strings.ToUpper(appName)),
+		roffEscape(c.appValue("version")),
+		roffEscape(appName),
+	))
+	builder.WriteString(".SH NAME\n")
+	builder.WriteString(roffEscape(appName))
+
+	if description := c.appValue("description"); description != "" {
+		builder.WriteString(fmt.Sprintf(" \\- %s", roffEscape(description)))
+	}
+
+	builder.WriteString("\n")
+
+	if len(c.commands) > 0 {
+		builder.WriteString(".SH COMMANDS\n")
+
+		for _, command := range c.commands {
+			builder.WriteString(fmt.Sprintf(".TP\n.B %s\n%s\n", roffEscape(command.name), roffEscape(command.description)))
+		}
+	}
+
+	builder.WriteString(".SH CONFIGURATION\n")
+
+	for _, fieldSet := range fieldSets {
+		builder.WriteString(fmt.Sprintf(".SS \"%s\"\n", roffEscape(fieldSet.key)))
+
+		if fieldSet.command != "" {
+			builder.WriteString(fmt.Sprintf("Loaded for command \\fB%s\\fR.\n", roffEscape(fieldSet.command)))
+		}
+
+		for _, field := range fieldSet.fields {
+			builder.WriteString(fmt.Sprintf(".TP\n.B %s\n", roffEscape(field.key)))
+
+			if field.description != "" {
+				builder.WriteString(fmt.Sprintf("%s\n", roffEscape(field.description)))
+			}
+
+			builder.WriteString(".RS\n")
+
+			for _, detail := range field.details {
+				builder.WriteString(fmt.Sprintf(".IP \\(bu 2\n%s\n", roffEscape(detail.label+": "+detail.value)))
+			}
+
+			builder.WriteString(".RE\n")
+		}
+	}
+
+	return builder.String()
+}
+
+func (c *AppConfig) htmlDocs(appName string, fieldSets []docsFieldSet) string {
+	builder := strings.Builder{}
+	title := html.EscapeString(fmt.Sprintf("%s configuration reference", appName))
+
+	builder.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	builder.WriteString(fmt.Sprintf("<title>%s</title>\n</head>\n<body>\n<h1>%s</h1>\n", title, title))
+
+	if description := c.appValue("description"); description != "" {
+		builder.WriteString(fmt.Sprintf("<p>%s</p>\n", html.EscapeString(description)))
+	}
+
+	if len(c.commands) > 0 {
+		builder.WriteString("<h2>Commands</h2>\n<dl>\n")
+
+		for _, command := range c.commands {
+			builder.WriteString(fmt.Sprintf(
+				"<dt><code>%s</code></dt>\n<dd>%s</dd>\n",
+				html.EscapeString(command.name),
+				html.EscapeString(command.description),
+			))
+		}
+
+		builder.WriteString("</dl>\n")
+	}
+
+	for _, fieldSet := range fieldSets {
+		builder.WriteString(fmt.Sprintf(
+			"<h2 id=\"%s\">Field-set <code>%s</code></h2>\n",
+			html.EscapeString(fieldSet.key),
+			html.EscapeString(fieldSet.key),
+		))
+
+		if fieldSet.command != "" {
+			builder.WriteString(fmt.Sprintf(
+				"<p>Loaded for command <code>%s</code>.</p>\n", html.EscapeString(fieldSet.command),
+			))
+		}
+
+		for _, field := range fieldSet.fields {
+			builder.WriteString(fmt.Sprintf(
+				"<h3 id=\"%s\"><code>%s</code></h3>\n", html.EscapeString(field.key), html.EscapeString(field.key),
+			))
+
+			if field.description != "" {
+				builder.WriteString(fmt.Sprintf("<p>%s</p>\n", html.EscapeString(field.description)))
+			}
+
+			builder.WriteString("<ul>\n")
+
+			for _, detail := range field.details {
+				builder.WriteString(fmt.Sprintf(
+					"<li>%s: <code>%s</code></li>\n", html.EscapeString(detail.label), html.EscapeString(detail.value),
+				))
+			}
+
+			builder.WriteString("</ul>\n")
+		}
+	}
+
+	builder.WriteString("</body>\n</html>\n")
+
+	return builder.String()
+}
+
+// roffEscape escapes backslashes and hyphens, and prevents lines starting with a control character from being parsed
+// as roff requests.
+func roffEscape(value string) string {
+	value = strings.ReplaceAll(value, "\\", "\\e")
+	value = strings.ReplaceAll(value, "-", "\\-")
+
+	lines := strings.Split(value, "\n")
+
+	for idx, line := range lines {
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			lines[idx] = "\\&" + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
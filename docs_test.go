@@ -0,0 +1,84 @@
+package bconf_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigDocs(t *testing.T) {
+	args := os.Args
+	os.Args = []string{"testapp"}
+
+	t.Cleanup(func() { os.Args = args })
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("testapp"),
+		bconf.WithFlagLoader(),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("db").Fields(
+		bconf.FB("host", bconf.String).Default("localhost").Description("database <host>").C(),
+		bconf.FB("password", bconf.String).Default("secret").Sensitive().C(),
+		bconf.FB("driver", bconf.String).Default("postgres").Enumeration("postgres", "mysql").C(),
+	).C())
+
+	appConfig.AddFieldSet(bconf.FSB("cache").LoadConditions(
+		bconf.LCB(func(f bconf.FieldValueFinder) (bool, error) { return false, nil }).
+			AddFieldDependencies(bconf.FD("db", "driver")).C(),
+	).Fields(bconf.FB("url", bconf.String).Required().C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	expectedContent := map[string][]string{
+		bconf.DocsFormatMarkdown: {
+			"# testapp configuration reference",
+			"## Field-set `db`",
+			"### `db.host`\n\ndatabase <host>",
+			"* Default value: `<sensitive-value>`",
+			"* Accepted values: `['postgres', 'mysql']`",
+			"* Environment key: `'TESTAPP_DB_HOST'`",
+			"* Flag argument: `'--db_host'`",
+			"* Required: `yes, when loaded`",
+			"* Loading depends on field(s): `'db.driver'`",
+		},
+		bconf.DocsFormatMan: {
+			".TH \"TESTAPP\" \"5\"",
+			".SS \"db\"",
+			".B db.host",
+			"Flag argument: '\\-\\-db_host'",
+		},
+		bconf.DocsFormatHTML: {
+			"<h3 id=\"db.host\"><code>db.host</code></h3>",
+			"<p>database &lt;host&gt;</p>",
+			"<li>Sensitive: <code>yes</code></li>",
+		},
+	}
+
+	for format, contents := range expectedContent {
+		docs, err := appConfig.Docs(format)
+		if err != nil {
+			t.Fatalf("unexpected error generating %s docs: %s", format, err)
+		}
+
+		for _, content := range contents {
+			if !strings.Contains(docs, content) {
+				t.Errorf("expected %s docs to contain '%s', found:\n%s", format, content, docs)
+			}
+		}
+
+		if strings.Contains(docs, "secret") {
+			t.Errorf("unexpected sensitive value in %s docs:\n%s", format, docs)
+		}
+	}
+
+	if _, err := appConfig.Docs("pdf"); err == nil {
+		t.Errorf("expected error generating docs in an unsupported format")
+	}
+}
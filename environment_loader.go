@@ -28,7 +28,7 @@ func (l *EnvironmentLoader) CloneLoader() Loader {
 }
 
 func (l *EnvironmentLoader) Name() string {
-	return "bconf_environment"
+	return LoaderNameEnvironment
 }
 
 func (l *EnvironmentLoader) Get(fieldSetKey, fieldKey string) (string, bool) {
@@ -48,6 +48,30 @@ func (l *EnvironmentLoader) GetMap(fieldSetKey string, fieldKeys []string) map[s
 	return values
 }
 
+func (l *EnvironmentLoader) Key(fieldSetKey, fieldKey string) string {
+	return l.environmentKey(fmt.Sprintf("%s_%s", fieldSetKey, fieldKey))
+}
+
+// SeenKeys returns environment variable names that start with the loader key prefix. Without a key prefix, the
+// loader cannot distinguish configuration from other environment variables, and no keys are returned.
+func (l *EnvironmentLoader) SeenKeys() []string {
+	if l.KeyPrefix == "" {
+		return nil
+	}
+
+	keys := []string{}
+	prefix := strings.ToUpper(l.KeyPrefix) + "_"
+
+	for _, variable := range os.Environ() {
+		key, _, _ := strings.Cut(variable, "=")
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
 func (l *EnvironmentLoader) HelpString(fieldSetKey, fieldKey string) string {
 	return fmt.Sprintf("Environment key: '%s'", l.environmentKey(fmt.Sprintf("%s_%s", fieldSetKey, fieldKey)))
 }
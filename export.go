@@ -0,0 +1,343 @@
+package bconf
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ExportFormatJSON = "json"
+	ExportFormatYAML = "yaml"
+	ExportFormatEnv  = "env"
+	ExportFormatFlat = "flat"
+
+	// DurationFormatString formats durations as duration strings (e.g. '1m30s')
+	DurationFormatString = "string"
+	// DurationFormatMilliseconds formats durations as a number of milliseconds
+	DurationFormatMilliseconds = "milliseconds"
+	// DurationFormatSeconds formats durations as a number of seconds
+	DurationFormatSeconds = "seconds"
+
+	sensitiveValueMask = "<sensitive-value>"
+)
+
+// MaskStrategy masks the string representation of a sensitive field value.
+type MaskStrategy func(value string) string
+
+// MaskFull replaces sensitive values with '<sensitive-value>'.
+func MaskFull() MaskStrategy {
+	return func(_ string) string {
+		return sensitiveValueMask
+	}
+}
+
+// MaskLastFour replaces all but the last four characters of sensitive values with '*'. Values with four characters or
+// fewer are fully masked.
+func MaskLastFour() MaskStrategy {
+	return func(value string) string {
+		runes := []rune(value)
+		if len(runes) <= 4 {
+			return strings.Repeat("*", len(runes))
+		}
+
+		return strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-4:])
+	}
+}
+
+// MaskSaltedHash replaces sensitive values with a salted SHA-256 hash ('sha256:<hex>'), allowing value changes to be
+// detected without exposing values.
+func MaskSaltedHash(salt string) MaskStrategy {
+	return func(value string) string {
+		sum := sha256.Sum256([]byte(salt + value))
+
+		return "sha256:" + hex.EncodeToString(sum[:])
+	}
+}
+
+// ExportOptions configures the AppConfig Export method.
+type ExportOptions struct {
+	// Mask masks sensitive field values (default: MaskFull)
+	Mask MaskStrategy
+	// Format is one of ExportFormatJSON (default), ExportFormatYAML, ExportFormatEnv, or ExportFormatFlat
+	Format string
+	// DurationFormat is one of DurationFormatString (default), DurationFormatMilliseconds, or DurationFormatSeconds
+	DurationFormat string
+}
+
+// Export renders the effective configuration values of the loaded field-sets, masking sensitive values. Fields
+// without a value are omitted. The JSON and YAML formats nest fields under their field-set key, the env format
+// writes environment variable assignments using the environment loader key (e.g. 'API_HOST=localhost'), and the flat
+// format writes 'field-set.field=value' lines.
+func (c *AppConfig) Export(options ExportOptions) ([]byte, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if options.Mask == nil {
+		options.Mask = MaskFull()
+	}
+
+	switch options.DurationFormat {
+	case "":
+		options.DurationFormat = DurationFormatString
+	case DurationFormatString, DurationFormatMilliseconds, DurationFormatSeconds:
+	default:
+		return nil, fmt.Errorf("problem exporting config: unsupported duration format '%s'", options.DurationFormat)
+	}
+
+	values := c.exportValues(options)
+
+	switch options.Format {
+	case "", ExportFormatJSON:
+		return exportJSON(values)
+	case ExportFormatYAML:
+		return exportYAML(values), nil
+	case ExportFormatEnv:
+		return c.exportEnv(values), nil
+	case ExportFormatFlat:
+		return exportFlat(values), nil
+	default:
+		return nil, fmt.Errorf("problem exporting config: unsupported format '%s'", options.Format)
+	}
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type exportFieldSet struct {
+	key    string
+	fields []exportField
+}
+
+type exportField struct {
+	value     any
+	key       string
+	delimiter string
+}
+
+func (c *AppConfig) exportValues(options ExportOptions) []exportFieldSet {
+	fieldSets := []exportFieldSet{}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		if !c.fieldSetInCommandScope(fieldSet.Key, c.selectedCommand) {
+			continue
+		}
+
+		exportSet := exportFieldSet{key: fieldSet.Key}
+
+		for _, fieldKey := range slices.Sorted(slices.Values(fieldSet.fieldKeys())) {
+			field := fieldSet.fieldMap[fieldKey]
+
+			value, err := field.getValue()
+			if err != nil {
+				continue
+			}
+
+			if field.Sensitive {
+				value = options.Mask(exportString(exportValue(value, options.DurationFormat), field.listDelimiter()))
+			} else {
+				value = exportValue(value, options.DurationFormat)
+			}
+
+			exportSet.fields = append(exportSet.fields, exportField{
+				key:       field.Key,
+				value:     value,
+				delimiter: field.listDelimiter(),
+			})
+		}
+
+		fieldSets = append(fieldSets, exportSet)
+	}
+
+	return fieldSets
+}
+
+// exportValue converts time and duration values to their exported representation.
+func exportValue(value any, durationFormat string) any {
+	switch typedValue := value.(type) {
+	case time.Duration:
+		return exportDuration(typedValue, durationFormat)
+	case []time.Duration:
+		values := make([]any, len(typedValue))
+
+		for idx, elem := range typedValue {
+			values[idx] = exportDuration(elem, durationFormat)
+		}
+
+		return values
+	default:
+		return jsonSchemaValue(value)
+	}
+}
+
+func exportDuration(value time.Duration, durationFormat string) any {
+	switch durationFormat {
+	case DurationFormatMilliseconds:
+		return value.Milliseconds()
+	case DurationFormatSeconds:
+		return value.Seconds()
+	default:
+		return value.String()
+	}
+}
+
+// exportString returns the string representation of an exported value, joining list values with the delimiter and
+// quoting elements containing the delimiter.
+func exportString(value any, delimiter string) string {
+	if values, isList := exportListValues(value); isList {
+		elems := make([]string, len(values))
+
+		for idx, elem := range values {
+			elems[idx] = fmt.Sprint(elem)
+		}
+
+		return joinListValues(elems, delimiter)
+	}
+
+	return fmt.Sprint(value)
+}
+
+func exportJSON(fieldSets []exportFieldSet) ([]byte, error) {
+	configMap := map[string]map[string]any{}
+
+	for _, fieldSet := range fieldSets {
+		fieldSetMap := map[string]any{}
+
+		for _, field := range fieldSet.fields {
+			fieldSetMap[field.key] = field.value
+		}
+
+		configMap[fieldSet.key] = fieldSetMap
+	}
+
+	exported, err := json.MarshalIndent(configMap, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("problem exporting config as json: %w", err)
+	}
+
+	return append(exported, '\n'), nil
+}
+
+func exportYAML(fieldSets []exportFieldSet) []byte {
+	builder := strings.Builder{}
+
+	for _, fieldSet := range fieldSets {
+		if len(fieldSet.fields) < 1 {
+			builder.WriteString(fmt.Sprintf("%s: {}\n", fieldSet.key))
+
+			continue
+		}
+
+		builder.WriteString(fmt.Sprintf("%s:\n", fieldSet.key))
+
+		for _, field := range fieldSet.fields {
+			values, isList := exportListValues(field.value)
+			if !isList {
+				builder.WriteString(fmt.Sprintf("  %s: %s\n", field.key, yamlScalar(field.value)))
+
+				continue
+			}
+
+			if len(values) < 1 {
+				builder.WriteString(fmt.Sprintf("  %s: []\n", field.key))
+
+				continue
+			}
+
+			builder.WriteString(fmt.Sprintf("  %s:\n", field.key))
+
+			for _, value := range values {
+				builder.WriteString(fmt.Sprintf("    - %s\n", yamlScalar(value)))
+			}
+		}
+	}
+
+	return []byte(builder.String())
+}
+
+func (c *AppConfig) exportEnv(fieldSets []exportFieldSet) []byte {
+	builder := strings.Builder{}
+
+	var environmentLoader *EnvironmentLoader
+
+	for _, loader := range c.loaders {
+		if castLoader, ok := loader.(*EnvironmentLoader); ok {
+			environmentLoader = castLoader
+		}
+	}
+
+	for _, fieldSet := range fieldSets {
+		for _, field := range fieldSet.fields {
+			key := strings.ToUpper(fmt.Sprintf("%s_%s", fieldSet.key, field.key))
+			if environmentLoader != nil {
+				key = environmentLoader.Key(fieldSet.key, field.key)
+			}
+
+			builder.WriteString(fmt.Sprintf("%s=%s\n", key, envFileValue(exportString(field.value, field.delimiter))))
+		}
+	}
+
+	return []byte(builder.String())
+}
+
+func exportFlat(fieldSets []exportFieldSet) []byte {
+	builder := strings.Builder{}
+
+	for _, fieldSet := range fieldSets {
+		for _, field := range fieldSet.fields {
+			builder.WriteString(fmt.Sprintf("%s.%s=%s\n", fieldSet.key, field.key, exportString(field.value, field.delimiter)))
+		}
+	}
+
+	return []byte(builder.String())
+}
+
+func exportListValues(value any) ([]any, bool) {
+	switch typedValue := value.(type) {
+	case []any:
+		return typedValue, true
+	case []string:
+		return toAnySlice(typedValue), true
+	case []int:
+		return toAnySlice(typedValue), true
+	case []bool:
+		return toAnySlice(typedValue), true
+	case []float64:
+		return toAnySlice(typedValue), true
+	default:
+		return nil, false
+	}
+}
+
+func toAnySlice[T any](values []T) []any {
+	anyValues := make([]any, len(values))
+
+	for idx, value := range values {
+		anyValues[idx] = value
+	}
+
+	return anyValues
+}
+
+func yamlScalar(value any) string {
+	if stringValue, ok := value.(string); ok {
+		return strconv.Quote(stringValue)
+	}
+
+	return fmt.Sprint(value)
+}
+
+// envFileValue double-quotes values containing whitespace, quotes, or shell special characters.
+func envFileValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, " \t\n\"'\\$#`=") {
+		return value
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`, "`", "\\`")
+
+	return `"` + replacer.Replace(value) + `"`
+}
@@ -0,0 +1,105 @@
+package bconf_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigExport(t *testing.T) {
+	appConfig := bconf.NewAppConfig("testapp", "testapp description", bconf.WithEnvironmentLoader("export"))
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).Default("local host").C(),
+		bconf.FB("timeout", bconf.Duration).Default(1500*time.Millisecond).C(),
+		bconf.FB("ports", bconf.Ints).Default([]int{80, 443}).C(),
+		bconf.FB("token", bconf.String).Default("secret-token").Sensitive().C(),
+		bconf.FB("unset", bconf.String).C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	exported, err := appConfig.Export(bconf.ExportOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error exporting json: %s", err)
+	}
+
+	configMap := map[string]map[string]any{}
+	if err := json.Unmarshal(exported, &configMap); err != nil {
+		t.Fatalf("unexpected error decoding exported json: %s", err)
+	}
+
+	api := configMap["api"]
+
+	if api["host"] != "local host" || api["timeout"] != "1.5s" || api["token"] != "<sensitive-value>" {
+		t.Errorf("unexpected exported json values: %v", api)
+	}
+
+	if _, found := api["unset"]; found {
+		t.Errorf("unexpected unset field in exported json: %v", api)
+	}
+
+	if configMap["app"]["name"] != "testapp" {
+		t.Errorf("expected app name in exported json: %v", configMap["app"])
+	}
+
+	expectedContent := map[string]struct {
+		options  bconf.ExportOptions
+		contents []string
+	}{
+		"yaml": {
+			options: bconf.ExportOptions{Format: bconf.ExportFormatYAML, DurationFormat: bconf.DurationFormatMilliseconds},
+			contents: []string{
+				"api:\n  host: \"local host\"\n  ports:\n    - 80\n    - 443\n  timeout: 1500\n  token: \"<sensitive-value>\"\n",
+			},
+		},
+		"env": {
+			options: bconf.ExportOptions{Format: bconf.ExportFormatEnv, Mask: bconf.MaskLastFour()},
+			contents: []string{
+				"EXPORT_API_HOST=\"local host\"\n",
+				"EXPORT_API_PORTS=80,443\n",
+				"EXPORT_API_TOKEN=********oken\n",
+			},
+		},
+		"flat": {
+			options:  bconf.ExportOptions{Format: bconf.ExportFormatFlat, DurationFormat: bconf.DurationFormatSeconds},
+			contents: []string{"api.host=local host\n", "api.timeout=1.5\n", "app.name=testapp\n"},
+		},
+	}
+
+	for name, test := range expectedContent {
+		exported, err := appConfig.Export(test.options)
+		if err != nil {
+			t.Fatalf("unexpected error exporting %s: %s", name, err)
+		}
+
+		for _, content := range test.contents {
+			if !strings.Contains(string(exported), content) {
+				t.Errorf("expected %s export to contain '%s', found:\n%s", name, content, exported)
+			}
+		}
+	}
+
+	hashOptions := bconf.ExportOptions{Format: bconf.ExportFormatFlat, Mask: bconf.MaskSaltedHash("salt")}
+
+	first, _ := appConfig.Export(hashOptions)
+	second, _ := appConfig.Export(hashOptions)
+
+	if string(first) != string(second) || !strings.Contains(string(first), "api.token=sha256:") ||
+		strings.Contains(string(first), "secret-token") {
+		t.Errorf("unexpected salted hash masked export:\n%s", first)
+	}
+
+	if _, err := appConfig.Export(bconf.ExportOptions{Format: "xml"}); err == nil {
+		t.Errorf("expected error exporting an unsupported format")
+	}
+
+	if _, err := appConfig.Export(bconf.ExportOptions{DurationFormat: "hours"}); err == nil {
+		t.Errorf("expected error exporting with an unsupported duration format")
+	}
+}
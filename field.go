@@ -8,7 +8,10 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/xavi-group/bconf/bconfconst"
 )
@@ -20,14 +23,22 @@ type Fields []*Field
 
 // Field is a data structure that provides context for a configuration value
 type Field struct {
+	// lock is the lock of the AppConfig the field is registered with, guarding field values
+	lock *sync.RWMutex
 	// fieldValue contains a mapping of loader names to field value
 	fieldValue map[string]any
+	// Transformers defines transformers applied in order to a parsed field value before enumeration and validation
+	// checks. String transformers are also applied to raw values of non-string field-types before parsing
+	Transformers []Transformer
 	// Validator defines a function that runs during validation to check a value against validity constraints
 	Validator func(value any) error
 	// DefaultGenerator defines a function that creates a base value for a field
 	DefaultGenerator func() (any, error)
 	// Default defines a base value for a field
 	Default any
+	// ProfileDefaults defines base values for a field that take precedence over Default when the matching profile is
+	// active
+	ProfileDefaults map[string]any
 	// generatedDefault tracks the value generated from the default generator function
 	generatedDefault any
 	// overrideValue tracks a user set field value
@@ -38,16 +49,38 @@ type Field struct {
 	Type string
 	// Description defines a summary of the field contents
 	Description string
-	// Enumeration defines a list of acceptable inputs for the field value
+	// Deprecated marks the field as deprecated with a message describing its replacement, and a warning is recorded
+	// whenever a loader sets the field value
+	Deprecated string
+	// FlagShorthand defines a single-letter flag alias for the field (e.g. 'p' for '-p')
+	FlagShorthand string
+	// ListDelimiter defines the delimiter separating list field elements in loader string values, defaulting to
+	// DefaultListDelimiter
+	ListDelimiter string
+	// Enumeration defines a list of acceptable inputs for the field value. For list field-types, enumeration values
+	// are element values, and each list element must be found in the enumeration
 	Enumeration []any
+	// EnumerationDescriptions defines descriptions of enumeration values shown in help output, keyed by value
+	EnumerationDescriptions map[any]string
 	// LoadConditions defines the conditions required for a field to load values
 	LoadConditions LoadConditions
+	// AllowedLoaders defines the names of the only loaders permitted to set the field value
+	AllowedLoaders []string
+	// DeniedLoaders defines the names of loaders that are not permitted to set the field value
+	DeniedLoaders []string
 	// fieldFound is a reverse priority list of where field values were found, e.g. last value has highest priority
 	fieldFound []string
+	// profile tracks the active application profile used to select a profile default
+	profile string
 	// Required defines whether a field value must be set in order for the field to be valid
 	Required bool
 	// Sensitive identifies the field value as sensitive
 	Sensitive bool
+	// EnumerationCaseInsensitive defines whether string values are matched against the enumeration ignoring case, where
+	// matched values are normalized to the enumeration value
+	EnumerationCaseInsensitive bool
+	// Internal identifies the field as internal to the application, allowing it to be hidden from help output
+	Internal bool
 }
 
 func (f *Field) Clone() *Field {
@@ -55,7 +88,12 @@ func (f *Field) Clone() *Field {
 
 	clone.fieldFound = slices.Clone(f.fieldFound)
 	clone.Enumeration = slices.Clone(f.Enumeration)
+	clone.EnumerationDescriptions = maps.Clone(f.EnumerationDescriptions)
+	clone.Transformers = slices.Clone(f.Transformers)
+	clone.AllowedLoaders = slices.Clone(f.AllowedLoaders)
+	clone.DeniedLoaders = slices.Clone(f.DeniedLoaders)
 	clone.fieldValue = maps.Clone(f.fieldValue)
+	clone.ProfileDefaults = maps.Clone(f.ProfileDefaults)
 
 	if len(f.LoadConditions) > 0 {
 		clone.LoadConditions = make(LoadConditions, len(f.LoadConditions))
@@ -119,6 +157,10 @@ func (f *Field) validate() []error {
 			errs = append(errs, err)
 		}
 
+		if validationErrs := f.validateProfileDefaultsFieldType(fieldType); len(validationErrs) > 0 {
+			errs = append(errs, validationErrs...)
+		}
+
 		if validationErrs := f.validateEnumerationValuesFieldType(fieldType); len(validationErrs) > 0 {
 			errs = append(errs, validationErrs...)
 		}
@@ -135,6 +177,10 @@ func (f *Field) validate() []error {
 		if err := f.validateDefaultValuesPassValidatorFunc(); err != nil {
 			errs = append(errs, err)
 		}
+
+		if validationErrs := f.validateProfileDefaultValues(); len(validationErrs) > 0 {
+			errs = append(errs, validationErrs...)
+		}
 	}
 
 	if !fieldTypeFound {
@@ -155,6 +201,25 @@ func (f *Field) validateNoConflictingParams() []error {
 		errs = append(errs, fmt.Errorf(bconfconst.ErrorFieldRequiredWithDefault))
 	}
 
+	if f.Required && len(f.ProfileDefaults) > 0 {
+		errs = append(errs, fmt.Errorf(bconfconst.ErrorFieldRequiredWithProfileDefault))
+	}
+
+	if len(f.AllowedLoaders) > 0 && len(f.DeniedLoaders) > 0 {
+		errs = append(errs, fmt.Errorf(bconfconst.ErrorFieldAllowedAndDeniedLoaders))
+	}
+
+	if err := f.validateListDelimiter(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if f.FlagShorthand != "" {
+		shorthand, size := utf8.DecodeRuneInString(f.FlagShorthand)
+		if size != len(f.FlagShorthand) || !unicode.IsLetter(shorthand) {
+			errs = append(errs, fmt.Errorf("invalid flag shorthand '%s': expected a single letter", f.FlagShorthand))
+		}
+	}
+
 	return errs
 }
 
@@ -190,6 +255,35 @@ func (f *Field) validateGeneratedDefaultFieldType(fieldType string) error {
 	)
 }
 
+func (f *Field) validateProfileDefaultsFieldType(fieldType string) []error {
+	errs := []error{}
+
+	for _, profile := range f.profiles() {
+		value := f.ProfileDefaults[profile]
+
+		if value == nil {
+			errs = append(errs, fmt.Errorf("invalid '%s' profile default: value cannot be nil", profile))
+			continue
+		}
+
+		if reflect.TypeOf(value).String() == fieldType {
+			continue
+		}
+
+		errs = append(
+			errs,
+			fmt.Errorf(
+				"invalid '%s' profile default type: expected '%s', found '%s'",
+				profile,
+				fieldType,
+				reflect.TypeOf(value).String(),
+			),
+		)
+	}
+
+	return errs
+}
+
 func (f *Field) validateEnumerationValuesFieldType(fieldType string) []error {
 	if len(f.Enumeration) < 1 {
 		return nil
@@ -197,17 +291,21 @@ func (f *Field) validateEnumerationValuesFieldType(fieldType string) []error {
 
 	errs := []error{}
 
+	if isListFieldType(fieldType) {
+		fieldType = fieldType[2:]
+	}
+
 	for _, val := range f.Enumeration {
-		if reflect.TypeOf(val).String() == fieldType {
+		if valType := reflect.TypeOf(val); valType != nil && valType.String() == fieldType {
 			continue
 		}
 
 		errs = append(
 			errs,
 			fmt.Errorf(
-				"invalid enumeration value type: expected '%s', found '%s'",
+				"invalid enumeration value type: expected '%s', found '%T'",
 				fieldType,
-				reflect.TypeOf(val).String(),
+				val,
 			),
 		)
 	}
@@ -255,6 +353,41 @@ func (f *Field) validateDefaultValuesPassValidatorFunc() error {
 	return nil
 }
 
+func (f *Field) validateProfileDefaultValues() []error {
+	errs := []error{}
+
+	for _, profile := range f.profiles() {
+		value := f.ProfileDefaults[profile]
+
+		if !f.valueInEnumeration(value) {
+			errs = append(errs, fmt.Errorf(
+				"invalid '%s' profile default value: default value '%v' expected in enumeration list",
+				profile,
+				value,
+			))
+
+			continue
+		}
+
+		if f.Validator != nil {
+			if err := f.Validator(value); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"invalid '%s' profile default value: error from field validator: %w",
+					profile,
+					err,
+				))
+			}
+		}
+	}
+
+	return errs
+}
+
+// profiles returns the sorted list of profiles that have a profile default defined.
+func (f *Field) profiles() []string {
+	return slices.Sorted(maps.Keys(f.ProfileDefaults))
+}
+
 func (f *Field) getValue() (any, error) {
 	if f.overrideValue != nil {
 		return f.overrideValue, nil
@@ -266,6 +399,10 @@ func (f *Field) getValue() (any, error) {
 		return value, nil
 	}
 
+	if value, found := f.ProfileDefaults[f.profile]; found && f.profile != "" {
+		return value, nil
+	}
+
 	if f.Default != nil {
 		return f.Default, nil
 	}
@@ -290,26 +427,44 @@ func (f *Field) getValue() (any, error) {
 // 	return value, nil
 // }
 
-func (f *Field) set(loaderName, value string) error {
+// parseLoaderValue parses a loader value to the field type, checking it against the field enumeration and validator.
+func (f *Field) parseLoaderValue(value string) (any, error) {
 	parsedValue, err := f.parseString(value)
 	if err != nil {
-		return fmt.Errorf("problem parsing value to field-type: %w", err)
+		return nil, fmt.Errorf("problem parsing value to field-type: %w", err)
 	}
 
-	if !f.valueInEnumeration(parsedValue) {
-		return fmt.Errorf("value not found in enumeration list")
+	return f.checkLoaderValue(parsedValue)
+}
+
+// checkLoaderValue transforms a loader value of the field-type, checking it against the field enumeration and
+// validator.
+func (f *Field) checkLoaderValue(parsedValue any) (any, error) {
+	parsedValue, err := f.transform(parsedValue)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedValue, found := f.enumerationValue(parsedValue)
+	if !found {
+		return nil, fmt.Errorf("value not found in enumeration list")
 	}
 
 	if f.Validator != nil {
 		if err := f.Validator(parsedValue); err != nil {
-			return fmt.Errorf("value validation error: %w", err)
+			return nil, fmt.Errorf("value validation error: %w", err)
 		}
 	}
 
+	return parsedValue, nil
+}
+
+// set records a parsed and validated loader value.
+func (f *Field) set(loaderName string, parsedValue any) {
 	if f.fieldValue == nil {
 		f.fieldValue = map[string]any{loaderName: parsedValue}
 	} else {
-		f.fieldValue[loaderName] = value
+		f.fieldValue[loaderName] = parsedValue
 	}
 
 	if f.fieldFound == nil {
@@ -317,153 +472,199 @@ func (f *Field) set(loaderName, value string) error {
 	} else {
 		f.fieldFound = append(f.fieldFound, loaderName)
 	}
+}
+
+// loaderAllowed checks whether the field value may be set by the loader with the provided name.
+func (f *Field) loaderAllowed(loaderName string) bool {
+	if len(f.AllowedLoaders) > 0 {
+		return slices.Contains(f.AllowedLoaders, loaderName)
+	}
 
-	return nil
+	return !slices.Contains(f.DeniedLoaders, loaderName)
+}
+
+// resetLoaderValues clears values previously set by loaders, leaving defaults and override values in place.
+func (f *Field) resetLoaderValues() {
+	f.fieldValue = nil
+	f.fieldFound = nil
 }
 
-func (f *Field) setOverride(value any) error {
+// checkOverrideValue checks an override value against the field-type, transformers, enumeration, and validator,
+// returning the value to set.
+func (f *Field) checkOverrideValue(value any) (any, error) {
 	if reflect.TypeOf(value).String() != f.Type {
-		return fmt.Errorf(
+		return nil, fmt.Errorf(
 			"invalid value field-type: expected '%s', found '%s'",
 			f.Type,
 			reflect.TypeOf(value).String(),
 		)
 	}
 
-	if !f.valueInEnumeration(value) {
-		return fmt.Errorf("value not found in enumeration list")
+	value, err := f.transform(value)
+	if err != nil {
+		return nil, err
+	}
+
+	value, found := f.enumerationValue(value)
+	if !found {
+		return nil, fmt.Errorf("value not found in enumeration list")
 	}
 
 	if f.Validator != nil {
 		if err := f.Validator(value); err != nil {
-			return fmt.Errorf("value validation error: %w", err)
+			return nil, fmt.Errorf("value validation error: %w", err)
 		}
 	}
 
-	f.overrideValue = value
-
-	return nil
+	return value, nil
 }
 
 func (f *Field) parseString(value string) (any, error) {
 	switch f.Type {
-	case String:
-		return value, nil
 	case Strings:
-		return f.parseToStrings(value), nil
-	case Bool:
-		return strconv.ParseBool(value)
+		return f.parseToStrings(value)
 	case Bools:
 		return f.parseToBools(value)
-	case Int:
-		return strconv.Atoi(value)
 	case Ints:
 		return f.parseToInts(value)
-	case Time:
-		return time.Parse(time.RFC3339, value)
+	case Floats:
+		return f.parseToFloats(value)
 	case Times:
 		return f.parseToTimes(value)
-	case Duration:
-		return time.ParseDuration(value)
 	case Durations:
 		return f.parseToDurations(value)
 	default:
-		return "", fmt.Errorf("unsupported field type: %s", f.Type)
+		value, err := f.transformRawString(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return parseScalarString(f.Type, value)
 	}
 }
 
-func (f *Field) parseToStrings(value string) []string {
-	if value == "" {
-		return []string{}
+// parseScalarString parses a value to a non-list field-type.
+func parseScalarString(fieldType, value string) (any, error) {
+	switch fieldType {
+	case String:
+		return value, nil
+	case Bool:
+		return strconv.ParseBool(value)
+	case Int:
+		return strconv.Atoi(value)
+	case Float:
+		return strconv.ParseFloat(value, 64)
+	case Time:
+		return time.Parse(time.RFC3339, value)
+	case Duration:
+		return time.ParseDuration(value)
+	default:
+		return "", fmt.Errorf("unsupported field type: %s", fieldType)
 	}
+}
 
-	list := strings.Split(value, ",")
-	values := make([]string, len(list))
-
-	for idx, elem := range list {
-		parsedValue := strings.Trim(elem, " ")
-		values[idx] = parsedValue
+func (f *Field) parseToStrings(value string) ([]string, error) {
+	if value == "" {
+		return []string{}, nil
 	}
 
-	return values
+	return f.listElements(value)
 }
 
 func (f *Field) parseToBools(value string) ([]bool, error) {
-	list := strings.Split(value, ",")
-	values := make([]bool, len(list))
+	return parseListValue(f, value, strconv.ParseBool)
+}
 
-	for idx, elem := range list {
-		parsedValue, err := strconv.ParseBool(strings.Trim(elem, " "))
-		if err != nil {
-			return nil, err
-		}
+func (f *Field) parseToInts(value string) ([]int, error) {
+	return parseListValue(f, value, strconv.Atoi)
+}
 
-		values[idx] = parsedValue
-	}
+func (f *Field) parseToFloats(value string) ([]float64, error) {
+	return parseListValue(f, value, func(elem string) (float64, error) {
+		return strconv.ParseFloat(elem, 64)
+	})
+}
 
-	return values, nil
+func (f *Field) parseToTimes(value string) ([]time.Time, error) {
+	return parseListValue(f, value, func(elem string) (time.Time, error) {
+		return time.Parse(time.RFC3339, elem)
+	})
 }
 
-func (f *Field) parseToInts(value string) ([]int, error) {
-	list := strings.Split(value, ",")
-	values := make([]int, len(list))
+func (f *Field) parseToDurations(value string) ([]time.Duration, error) {
+	return parseListValue(f, value, time.ParseDuration)
+}
 
-	for idx, elem := range list {
-		parsedValue, err := strconv.Atoi(strings.Trim(elem, " "))
-		if err != nil {
-			return nil, err
-		}
+func (f *Field) valueInEnumeration(value any) bool {
+	_, found := f.enumerationValue(value)
 
-		values[idx] = parsedValue
+	return found
+}
+
+// enumerationValue checks a value against the field enumeration, returning the value normalized to the matching
+// enumeration values. List values are checked element-wise.
+func (f *Field) enumerationValue(value any) (any, bool) {
+	if len(f.Enumeration) < 1 {
+		return value, true
 	}
 
-	return values, nil
-}
+	if !isListFieldType(f.Type) {
+		return f.enumerationElement(value)
+	}
 
-func (f *Field) parseToTimes(value string) ([]time.Time, error) {
-	list := strings.Split(value, ",")
-	values := make([]time.Time, len(list))
+	values := reflect.ValueOf(value)
+	if values.Kind() != reflect.Slice {
+		return value, false
+	}
 
-	for idx, elem := range list {
-		parsedValue, err := time.Parse(time.RFC3339, strings.Trim(elem, " "))
-		if err != nil {
-			return nil, err
+	normalizedValues := reflect.MakeSlice(values.Type(), values.Len(), values.Len())
+
+	for idx := range values.Len() {
+		element, found := f.enumerationElement(values.Index(idx).Interface())
+		if !found {
+			return value, false
 		}
 
-		values[idx] = parsedValue
+		normalizedValues.Index(idx).Set(reflect.ValueOf(element))
 	}
 
-	return values, nil
+	return normalizedValues.Interface(), true
 }
 
-func (f *Field) parseToDurations(value string) ([]time.Duration, error) {
-	list := strings.Split(value, ",")
-	values := make([]time.Duration, len(list))
+func (f *Field) enumerationElement(value any) (any, bool) {
+	stringValue, isString := value.(string)
 
-	for idx, elem := range list {
-		parsedValue, err := time.ParseDuration(strings.Trim(elem, " "))
-		if err != nil {
-			return nil, err
+	for _, acceptedValue := range f.Enumeration {
+		if value == acceptedValue {
+			return acceptedValue, true
 		}
 
-		values[idx] = parsedValue
+		if acceptedString, ok := acceptedValue.(string); ok && isString && f.EnumerationCaseInsensitive &&
+			strings.EqualFold(stringValue, acceptedString) {
+			return acceptedValue, true
+		}
 	}
 
-	return values, nil
+	return value, false
 }
 
-func (f *Field) valueInEnumeration(value any) bool {
-	if len(f.Enumeration) < 1 {
-		return true
+// enumerationDescription returns the description of an enumeration value, where non-comparable values have no
+// description.
+func (f *Field) enumerationDescription(value any) string {
+	if valueType := reflect.TypeOf(value); valueType != nil && !valueType.Comparable() {
+		return ""
 	}
 
-	for _, acceptedValue := range f.Enumeration {
-		if value == acceptedValue {
-			return true
-		}
+	return f.EnumerationDescriptions[value]
+}
+
+// enumerationLabel returns the label describing the accepted values of the field enumeration.
+func (f *Field) enumerationLabel() string {
+	if f.EnumerationCaseInsensitive {
+		return "Accepted values (case-insensitive)"
 	}
 
-	return false
+	return "Accepted values"
 }
 
 func (f *Field) enumerationString() string {
@@ -477,7 +678,11 @@ func (f *Field) enumerationString() string {
 				builder.WriteString(", ")
 			}
 
-			builder.WriteString(fmt.Sprintf("'%s'", value))
+			builder.WriteString(fmt.Sprintf("'%v'", value))
+
+			if description := f.enumerationDescription(value); description != "" {
+				builder.WriteString(fmt.Sprintf(" (%s)", description))
+			}
 		}
 
 		builder.WriteString("]")
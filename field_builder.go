@@ -1,6 +1,9 @@
 package bconf
 
-import "strings"
+import (
+	"reflect"
+	"strings"
+)
 
 func FB(fieldKey, fieldType string) FieldBuilder {
 	return NewFieldBuilder(fieldKey, fieldType)
@@ -14,17 +17,34 @@ func NewFieldBuilder(fieldKey, fieldType string) FieldBuilder {
 
 type FieldBuilder interface {
 	Default(value any) FieldBuilder
+	ProfileDefault(profile string, value any) FieldBuilder
 	Validator(validationFunc func(fieldValue any) error) FieldBuilder
+	Transform(transformers ...Transformer) FieldBuilder
 	DefaultGenerator(defaultGeneratorFunc func() (any, error)) FieldBuilder
 	LoadConditions(conditions ...LoadCondition) FieldBuilder
+	AllowedLoaders(loaderNames ...string) FieldBuilder
+	DeniedLoaders(loaderNames ...string) FieldBuilder
 	Description(description string, concat ...string) FieldBuilder
 	Enumeration(acceptedValues ...any) FieldBuilder
+	EnumerationEntries(entries ...EnumerationEntry) FieldBuilder
+	EnumerationCaseInsensitive() FieldBuilder
+	FlagShorthand(shorthand string) FieldBuilder
+	ListDelimiter(delimiter string) FieldBuilder
 	Required() FieldBuilder
 	Sensitive() FieldBuilder
+	Internal() FieldBuilder
+	Deprecated(message string) FieldBuilder
 	Create() *Field
 	C() *Field
 }
 
+// EnumerationEntry defines an accepted field value and a description of its meaning, added to a field with the
+// FieldBuilder EnumerationEntries method.
+type EnumerationEntry struct {
+	Value       any
+	Description string
+}
+
 // --------------------------------------------------------------------------------------------------------------------
 
 type fieldBuilder struct {
@@ -37,12 +57,33 @@ func (b *fieldBuilder) Default(value any) FieldBuilder {
 	return b
 }
 
+// ProfileDefault sets a base value used in place of Default when the provided application profile is active (e.g.
+// 'dev'). Profile defaults cannot be combined with Required.
+func (b *fieldBuilder) ProfileDefault(profile string, value any) FieldBuilder {
+	if b.field.ProfileDefaults == nil {
+		b.field.ProfileDefaults = map[string]any{}
+	}
+
+	b.field.ProfileDefaults[profile] = value
+
+	return b
+}
+
 func (b *fieldBuilder) Validator(value func(fieldValue any) error) FieldBuilder {
 	b.field.Validator = value
 
 	return b
 }
 
+// Transform appends transformers applied in order to loaded and set field values before enumeration and validator
+// checks (e.g. bconf.TrimSpace(), bconf.ToLower()). String transformers also apply to raw values of non-string
+// field-types before parsing.
+func (b *fieldBuilder) Transform(transformers ...Transformer) FieldBuilder {
+	b.field.Transformers = append(b.field.Transformers, transformers...)
+
+	return b
+}
+
 func (b *fieldBuilder) DefaultGenerator(value func() (any, error)) FieldBuilder {
 	b.field.DefaultGenerator = value
 
@@ -55,6 +96,24 @@ func (b *fieldBuilder) LoadConditions(value ...LoadCondition) FieldBuilder {
 	return b
 }
 
+// AllowedLoaders restricts the loaders permitted to set the field value to the provided loader names (e.g.
+// bconf.LoaderNameEnvironment), where values found by other loaders are ignored. A field cannot define both allowed and
+// denied loaders.
+func (b *fieldBuilder) AllowedLoaders(value ...string) FieldBuilder {
+	b.field.AllowedLoaders = value
+
+	return b
+}
+
+// DeniedLoaders prevents the loaders with the provided names from setting the field value (e.g. denying the flag
+// loader for a sensitive field), where values found by all other loaders are used. A field cannot define both allowed
+// and denied loaders.
+func (b *fieldBuilder) DeniedLoaders(value ...string) FieldBuilder {
+	b.field.DeniedLoaders = value
+
+	return b
+}
+
 func (b *fieldBuilder) Description(value string, concat ...string) FieldBuilder {
 	if len(concat) > 0 {
 		builder := strings.Builder{}
@@ -79,6 +138,52 @@ func (b *fieldBuilder) Enumeration(value ...any) FieldBuilder {
 	return b
 }
 
+// EnumerationEntries appends accepted values with descriptions shown in help output to the field enumeration.
+func (b *fieldBuilder) EnumerationEntries(entries ...EnumerationEntry) FieldBuilder {
+	for _, entry := range entries {
+		b.field.Enumeration = append(b.field.Enumeration, entry.Value)
+
+		// descriptions of non-comparable values are dropped, leaving the invalid value to fail field validation
+		valueType := reflect.TypeOf(entry.Value)
+		if entry.Description == "" || (valueType != nil && !valueType.Comparable()) {
+			continue
+		}
+
+		if b.field.EnumerationDescriptions == nil {
+			b.field.EnumerationDescriptions = map[any]string{}
+		}
+
+		b.field.EnumerationDescriptions[entry.Value] = entry.Description
+	}
+
+	return b
+}
+
+// EnumerationCaseInsensitive matches string values against the field enumeration ignoring case, normalizing matched
+// values to the enumeration value (e.g. 'INFO' is set as 'info').
+func (b *fieldBuilder) EnumerationCaseInsensitive() FieldBuilder {
+	b.field.EnumerationCaseInsensitive = true
+
+	return b
+}
+
+// FlagShorthand sets a single-letter flag alias for the field (e.g. 'p' for '-p 8080'). Shorthands must be unique
+// across the fields parsed with the selected command and cannot be 'h', which is reserved for help. Conflicting
+// shorthands are returned as Load errors.
+func (b *fieldBuilder) FlagShorthand(value string) FieldBuilder {
+	b.field.FlagShorthand = value
+
+	return b
+}
+
+// ListDelimiter sets the delimiter separating list field elements in loader string values (e.g. ';' to read
+// 'a,b;c' as the elements 'a,b' and 'c').
+func (b *fieldBuilder) ListDelimiter(delimiter string) FieldBuilder {
+	b.field.ListDelimiter = delimiter
+
+	return b
+}
+
 func (b *fieldBuilder) Required() FieldBuilder {
 	b.field.Required = true
 
@@ -91,6 +196,22 @@ func (b *fieldBuilder) Sensitive() FieldBuilder {
 	return b
 }
 
+// Internal marks the field as internal to the application, allowing it to be hidden from help output with the
+// HelpOptions HideInternalFields option.
+func (b *fieldBuilder) Internal() FieldBuilder {
+	b.field.Internal = true
+
+	return b
+}
+
+// Deprecated marks the field as deprecated, where the message describes the replacement (e.g. "use 'http.port'").
+// Loading a value for a deprecated field records a warning and logs it through the configured logger.
+func (b *fieldBuilder) Deprecated(message string) FieldBuilder {
+	b.field.Deprecated = message
+
+	return b
+}
+
 func (b *fieldBuilder) Create() *Field {
 	return b.field.Clone()
 }
@@ -47,6 +47,14 @@ func TestFieldBuilderDefault(t *testing.T) {
 	}
 }
 
+func TestFieldBuilderProfileDefault(t *testing.T) {
+	field := bconf.FB("field_key", bconf.String).Default("info").ProfileDefault("dev", "debug").Create()
+
+	if field.ProfileDefaults["dev"] != "debug" {
+		t.Fatalf("unexpected field 'dev' profile default '%v', expected 'debug'\n", field.ProfileDefaults["dev"])
+	}
+}
+
 func TestFieldBuilderValidator(t *testing.T) {
 	validator := func(_ any) error {
 		return fmt.Errorf("validator error")
@@ -84,6 +92,19 @@ func TestFieldBuilderLoadConditions(t *testing.T) {
 	}
 }
 
+func TestFieldBuilderLoaderRestrictions(t *testing.T) {
+	field := bconf.FB("field_key", bconf.String).AllowedLoaders(bconf.LoaderNameJSONFile).
+		DeniedLoaders(bconf.LoaderNameFlag).C()
+
+	if len(field.AllowedLoaders) != 1 || field.AllowedLoaders[0] != bconf.LoaderNameJSONFile {
+		t.Errorf("unexpected field allowed loaders: %v\n", field.AllowedLoaders)
+	}
+
+	if len(field.DeniedLoaders) != 1 || field.DeniedLoaders[0] != bconf.LoaderNameFlag {
+		t.Errorf("unexpected field denied loaders: %v\n", field.DeniedLoaders)
+	}
+}
+
 func TestFieldBuilderDescription(t *testing.T) {
 	const fieldDescription = "field description test"
 
@@ -105,6 +126,14 @@ func TestFieldBuilderEnumeration(t *testing.T) {
 	}
 }
 
+func TestFieldBuilderFlagShorthand(t *testing.T) {
+	field := bconf.FB("field_key", bconf.Int).FlagShorthand("p").Create()
+
+	if field.FlagShorthand != "p" {
+		t.Fatalf("unexpected field flag shorthand '%s', expected 'p'\n", field.FlagShorthand)
+	}
+}
+
 func TestFieldBuilderRequired(t *testing.T) {
 	field := bconf.FB("field_key", bconf.String).Required().Create()
 	if field.Required == false {
@@ -119,3 +148,54 @@ func TestFieldBuilderSensitive(t *testing.T) {
 		t.Fatal("expected field to be sensitive")
 	}
 }
+
+func TestFieldBuilderInternal(t *testing.T) {
+	field := bconf.FB("field_key", bconf.String).Internal().Create()
+
+	if !field.Internal {
+		t.Fatal("expected field to be internal")
+	}
+}
+
+func TestFieldBuilderDeprecated(t *testing.T) {
+	field := bconf.FB("field_key", bconf.String).Deprecated("use 'new_field_key'").Create()
+
+	if field.Deprecated != "use 'new_field_key'" {
+		t.Fatalf("unexpected field deprecation message '%s'", field.Deprecated)
+	}
+}
+
+func TestFieldBuilderTransform(t *testing.T) {
+	field := bconf.FB("field_key", bconf.String).Transform(bconf.TrimSpace()).Transform(bconf.ToLower()).Create()
+
+	if len(field.Transformers) != 2 {
+		t.Fatalf("unexpected field transformers length '%d', expected '2'", len(field.Transformers))
+	}
+}
+
+func TestFieldBuilderEnumerationEntries(t *testing.T) {
+	field := bconf.FB("field_key", bconf.String).Enumeration("warn").EnumerationEntries(
+		bconf.EnumerationEntry{Value: "debug", Description: "verbose output"},
+		bconf.EnumerationEntry{Value: "info"},
+	).EnumerationCaseInsensitive().Create()
+
+	if len(field.Enumeration) != 3 || field.Enumeration[1] != "debug" {
+		t.Fatalf("unexpected field enumeration: %v", field.Enumeration)
+	}
+
+	if len(field.EnumerationDescriptions) != 1 || field.EnumerationDescriptions["debug"] != "verbose output" {
+		t.Errorf("unexpected field enumeration descriptions: %v", field.EnumerationDescriptions)
+	}
+
+	if !field.EnumerationCaseInsensitive {
+		t.Error("expected field enumeration to be case-insensitive")
+	}
+}
+
+func TestFieldBuilderListDelimiter(t *testing.T) {
+	field := bconf.FB("field_key", bconf.Strings).ListDelimiter(";").Create()
+
+	if field.ListDelimiter != ";" {
+		t.Fatalf("unexpected field list delimiter '%s', expected ';'", field.ListDelimiter)
+	}
+}
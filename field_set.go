@@ -1,6 +1,9 @@
 package bconf
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+)
 
 type FieldSets []*FieldSet
 
@@ -9,11 +12,16 @@ type FieldSet struct {
 	Key            string
 	LoadConditions LoadConditions
 	Fields         Fields
+	// LoaderPrecedence overrides the application loader precedence for the field-set, listing loader names from
+	// lowest to highest priority. Unknown loader names are reported as AppConfig warnings
+	LoaderPrecedence []string
 }
 
 func (f *FieldSet) Clone() *FieldSet {
 	clone := *f
 
+	clone.LoaderPrecedence = slices.Clone(f.LoaderPrecedence)
+
 	if len(f.LoadConditions) > 0 {
 		clone.LoadConditions = make([]LoadCondition, len(f.LoadConditions))
 		for index, value := range f.LoadConditions {
@@ -108,6 +116,13 @@ func (f *FieldSet) validateFields() []error {
 	return errs
 }
 
+// setProfile sets the active application profile on each field, which is used to select field profile defaults.
+func (f *FieldSet) setProfile(profile string) {
+	for _, field := range f.fieldMap {
+		field.profile = profile
+	}
+}
+
 func (f *FieldSet) fieldKeys() []string {
 	keys := []string{}
 
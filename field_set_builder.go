@@ -13,6 +13,7 @@ func FSB(fieldSetKey string) FieldSetBuilder {
 type FieldSetBuilder interface {
 	Fields(fields ...*Field) FieldSetBuilder
 	LoadConditions(conditions ...LoadCondition) FieldSetBuilder
+	LoaderPrecedence(loaderNames ...string) FieldSetBuilder
 	Create() *FieldSet
 	C() *FieldSet
 }
@@ -35,6 +36,12 @@ func (b *fieldSetBuilder) LoadConditions(conditions ...LoadCondition) FieldSetBu
 	return b
 }
 
+func (b *fieldSetBuilder) LoaderPrecedence(loaderNames ...string) FieldSetBuilder {
+	b.fieldSet.LoaderPrecedence = loaderNames
+
+	return b
+}
+
 func (b *fieldSetBuilder) Create() *FieldSet {
 	return b.fieldSet.Clone()
 }
@@ -62,3 +62,11 @@ func TestFieldSetBuilderLoadConditions(t *testing.T) {
 		t.Fatalf("unexpected load-conditions length '%d', expected 1", len(fieldSet.LoadConditions))
 	}
 }
+
+func TestFieldSetBuilderLoaderPrecedence(t *testing.T) {
+	fieldSet := bconf.FSB("field_set_key").LoaderPrecedence(bconf.LoaderNameJSONFile, bconf.LoaderNameEnvironment).C()
+
+	if len(fieldSet.LoaderPrecedence) != 2 {
+		t.Fatalf("unexpected loader precedence length '%d', expected 2", len(fieldSet.LoaderPrecedence))
+	}
+}
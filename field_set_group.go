@@ -1,8 +1,142 @@
 package bconf
 
+import (
+	"cmp"
+	"slices"
+)
+
+// FieldSetGroups is a slice of FieldSetGroup elements.
+type FieldSetGroups []*FieldSetGroup
+
+// FieldSetGroup is a named collection of field-sets that is added to an AppConfig as a unit. Group load conditions
+// apply to every field-set in the group, allowing the group to be enabled or disabled as a whole. The group
+// description and order are used by help output and introspection.
+type FieldSetGroup struct {
+	// Name is a required value that identifies the group
+	Name string
+	// Description defines a summary of the group
+	Description string
+	// LoadConditions defines the conditions required for the group field-sets to load values
+	LoadConditions LoadConditions
+	// FieldSets defines the field-sets in the group
+	FieldSets FieldSets
+	// Order defines the position of the group in help output and introspection, with lower values listed first.
+	// Groups with equal order are listed in the order they were added.
+	Order int
+}
+
+func (g *FieldSetGroup) Clone() *FieldSetGroup {
+	clone := *g
+
+	if len(g.LoadConditions) > 0 {
+		clone.LoadConditions = make(LoadConditions, len(g.LoadConditions))
+
+		for idx, condition := range g.LoadConditions {
+			clone.LoadConditions[idx] = condition.Clone()
+		}
+	}
+
+	if len(g.FieldSets) > 0 {
+		clone.FieldSets = make(FieldSets, len(g.FieldSets))
+
+		for idx, fieldSet := range g.FieldSets {
+			clone.FieldSets[idx] = fieldSet.Clone()
+		}
+	}
+
+	return &clone
+}
+
+// FieldSetGroupInfo describes a field-set group registered with an AppConfig.
+type FieldSetGroupInfo struct {
+	Name        string
+	Description string
+	// Command is the name of the command the group belongs to, or empty for groups shared by all commands
+	Command string
+	// FieldSetKeys lists the keys of the group field-sets
+	FieldSetKeys []string
+	Order        int
+	// Conditional identifies groups with load conditions
+	Conditional bool
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 type fieldSetGroup struct {
-	name      string
-	fieldSets FieldSets
+	name           string
+	description    string
+	loadConditions LoadConditions
+	fieldSets      FieldSets
+	command        string
+	order          int
+	added          bool
+	// implicit marks groups created for field-sets added with AddFieldSet
+	implicit bool
 }
 
 type fieldSetGroups []*fieldSetGroup
+
+func newFieldSetGroup(group *FieldSetGroup, command string) *fieldSetGroup {
+	group = group.Clone()
+
+	return &fieldSetGroup{
+		name:           group.Name,
+		description:    group.Description,
+		loadConditions: group.LoadConditions,
+		fieldSets:      group.FieldSets,
+		command:        command,
+		order:          group.Order,
+	}
+}
+
+// groupFieldSets returns the group field-sets, with the group load conditions preceding the field-set load conditions.
+func (g *fieldSetGroup) groupFieldSets() FieldSets {
+	if len(g.loadConditions) < 1 {
+		return g.fieldSets
+	}
+
+	fieldSets := make(FieldSets, len(g.fieldSets))
+
+	for idx, fieldSet := range g.fieldSets {
+		fieldSet = fieldSet.Clone()
+		loadConditions := make(LoadConditions, 0, len(g.loadConditions)+len(fieldSet.LoadConditions))
+
+		for _, condition := range g.loadConditions {
+			loadConditions = append(loadConditions, condition.Clone())
+		}
+
+		fieldSet.LoadConditions = append(loadConditions, fieldSet.LoadConditions...)
+		fieldSets[idx] = fieldSet
+	}
+
+	return fieldSets
+}
+
+func (g *fieldSetGroup) info() FieldSetGroupInfo {
+	info := FieldSetGroupInfo{
+		Name:         g.name,
+		Description:  g.description,
+		Command:      g.command,
+		FieldSetKeys: make([]string, len(g.fieldSets)),
+		Order:        g.order,
+		Conditional:  len(g.loadConditions) > 0,
+	}
+
+	for idx, fieldSet := range g.fieldSets {
+		info.FieldSetKeys[idx] = fieldSet.Key
+	}
+
+	return info
+}
+
+// registered checks whether any groups were registered with AddFieldSetGroup or AddFieldSetGroups.
+func (g fieldSetGroups) registered() bool {
+	return slices.ContainsFunc(g, func(group *fieldSetGroup) bool { return !group.implicit })
+}
+
+// sorted returns the groups ordered by group order, keeping the registration order of groups with equal order.
+func (g fieldSetGroups) sorted() fieldSetGroups {
+	return slices.SortedStableFunc(slices.Values(g), func(a, b *fieldSetGroup) int {
+		return cmp.Compare(a.order, b.order)
+	})
+}
@@ -0,0 +1,58 @@
+package bconf
+
+func NewFieldSetGroupBuilder(groupName string) FieldSetGroupBuilder {
+	return &fieldSetGroupBuilder{group: &FieldSetGroup{Name: groupName}}
+}
+
+func FSGB(groupName string) FieldSetGroupBuilder {
+	return NewFieldSetGroupBuilder(groupName)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type FieldSetGroupBuilder interface {
+	Description(description string) FieldSetGroupBuilder
+	FieldSets(fieldSets ...*FieldSet) FieldSetGroupBuilder
+	LoadConditions(conditions ...LoadCondition) FieldSetGroupBuilder
+	Order(order int) FieldSetGroupBuilder
+	Create() *FieldSetGroup
+	C() *FieldSetGroup
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type fieldSetGroupBuilder struct {
+	group *FieldSetGroup
+}
+
+func (b *fieldSetGroupBuilder) Description(description string) FieldSetGroupBuilder {
+	b.group.Description = description
+
+	return b
+}
+
+func (b *fieldSetGroupBuilder) FieldSets(fieldSets ...*FieldSet) FieldSetGroupBuilder {
+	b.group.FieldSets = fieldSets
+
+	return b
+}
+
+func (b *fieldSetGroupBuilder) LoadConditions(conditions ...LoadCondition) FieldSetGroupBuilder {
+	b.group.LoadConditions = conditions
+
+	return b
+}
+
+func (b *fieldSetGroupBuilder) Order(order int) FieldSetGroupBuilder {
+	b.group.Order = order
+
+	return b
+}
+
+func (b *fieldSetGroupBuilder) Create() *FieldSetGroup {
+	return b.group.Clone()
+}
+
+func (b *fieldSetGroupBuilder) C() *FieldSetGroup {
+	return b.Create()
+}
@@ -0,0 +1,50 @@
+package bconf_test
+
+import (
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestFieldSetGroupBuilderCreate(t *testing.T) {
+	const groupName = "group_name"
+
+	group := bconf.NewFieldSetGroupBuilder(groupName).Create()
+	if group == nil {
+		t.Fatalf("unexpected nil field-set group")
+	}
+
+	if group.Name != groupName {
+		t.Errorf("unexpected field-set group name (expected '%s'), found: '%s'\n", groupName, group.Name)
+	}
+
+	group = bconf.FSGB(groupName).C()
+	if group == nil || group.Name != groupName {
+		t.Fatalf("unexpected field-set group from builder: %v", group)
+	}
+}
+
+func TestFieldSetGroupBuilderParameters(t *testing.T) {
+	group := bconf.FSGB("group_name").
+		Description("group description").
+		Order(2).
+		FieldSets(bconf.FSB("field_set_key").C()).
+		LoadConditions(bconf.LCB(func(_ bconf.FieldValueFinder) (bool, error) { return true, nil }).C()).
+		C()
+
+	if group.Description != "group description" {
+		t.Errorf("unexpected field-set group description: '%s'", group.Description)
+	}
+
+	if group.Order != 2 {
+		t.Errorf("unexpected field-set group order '%d', expected '2'", group.Order)
+	}
+
+	if len(group.FieldSets) != 1 || group.FieldSets[0].Key != "field_set_key" {
+		t.Errorf("unexpected field-set group field-sets: %v", group.FieldSets)
+	}
+
+	if len(group.LoadConditions) != 1 {
+		t.Errorf("unexpected field-set group load conditions length '%d', expected '1'", len(group.LoadConditions))
+	}
+}
@@ -3,9 +3,18 @@ package bconf
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
+// reservedFlags are flags handled by the AppConfig that are never reported as unknown flags.
+var reservedFlags = []string{"help", "h", generateCompletionFlag, generateDocsFlag, checkConfigFlag}
+
+// reservedBoolFlags are reserved flags that never consume the following argument as a value.
+var reservedBoolFlags = []string{"help", "h", checkConfigFlag}
+
 func NewFlagLoader() *FlagLoader {
 	return NewFlagLoaderWithKeyPrefix("")
 }
@@ -14,9 +23,24 @@ func NewFlagLoaderWithKeyPrefix(keyPrefix string) *FlagLoader {
 	return &FlagLoader{KeyPrefix: keyPrefix}
 }
 
+// FlagLoader loads field values from command-line flags following POSIX/GNU conventions: '--key=value',
+// '--key value', boolean '--key' and '--no-key' flags, single-letter shorthand flags ('-k value', '-abc' for boolean
+// shorthands), negative number values, and a '--' terminator after which arguments are not parsed. Repeated flags
+// accumulate into list field values, and the last value is used for other field types. Flags of command field-sets
+// are only accepted after the selected command argument.
 type FlagLoader struct {
+	fields         map[string]LoaderField
+	shorthands     map[string]string
+	command        string
 	KeyPrefix      string
 	OverrideLookup []string
+	// prepared caches the parsed command-line arguments between Prepare and Finish
+	prepared *preparedFlags
+}
+
+type preparedFlags struct {
+	result flagParseResult
+	values map[string]string
 }
 
 func (l *FlagLoader) Clone() *FlagLoader {
@@ -26,6 +50,8 @@ func (l *FlagLoader) Clone() *FlagLoader {
 		_ = copy(clone.OverrideLookup, l.OverrideLookup)
 	}
 
+	clone.prepared = nil
+
 	return &clone
 }
 
@@ -34,7 +60,79 @@ func (l *FlagLoader) CloneLoader() Loader {
 }
 
 func (l *FlagLoader) Name() string {
-	return "bconf_flags"
+	return LoaderNameFlag
+}
+
+// SetFields registers field metadata used to parse boolean flags, list flags, and flag shorthands.
+func (l *FlagLoader) SetFields(fields []LoaderField) {
+	l.fields = make(map[string]LoaderField, len(fields))
+
+	for _, field := range fields {
+		l.fields[fmt.Sprintf("%s_%s", field.FieldSetKey, field.FieldKey)] = field
+	}
+
+	l.setShorthands()
+	l.prepared = nil
+}
+
+// SetCommand sets the selected command, scoping command field flags and shorthands to the command.
+func (l *FlagLoader) SetCommand(command string) {
+	l.command = command
+
+	l.setShorthands()
+	l.prepared = nil
+}
+
+// Prepare parses the command-line arguments once, caching the parsed flags for lookups until Finish is called.
+func (l *FlagLoader) Prepare() {
+	l.prepared = nil
+
+	result := l.parse()
+
+	l.prepared = &preparedFlags{result: result, values: l.parsedFlagValues(result)}
+}
+
+// Finish releases the flags cached by Prepare.
+func (l *FlagLoader) Finish() {
+	l.prepared = nil
+}
+
+func (l *FlagLoader) setShorthands() {
+	l.shorthands = map[string]string{}
+
+	for key, field := range l.fields {
+		if field.FlagShorthand != "" && (field.Command == "" || field.Command == l.command) {
+			l.shorthands[field.FlagShorthand] = key
+		}
+	}
+}
+
+// Errors reports flags missing a required value and command flags outside of the selected command. Unknown flags are
+// reported by strict mode using SeenKeys.
+func (l *FlagLoader) Errors() []error {
+	return l.parse().errs
+}
+
+func (l *FlagLoader) Key(fieldSetKey, fieldKey string) string {
+	return l.flagKey(fmt.Sprintf("%s_%s", fieldSetKey, fieldKey))
+}
+
+// SeenKeys returns the flag names found in the command-line arguments, excluding reserved flags. Flags matching a
+// registered field are returned in the format returned by Key, and other flags are returned as they were given.
+func (l *FlagLoader) SeenKeys() []string {
+	keys := []string{}
+
+	for _, name := range l.parse().names {
+		switch {
+		case isReservedFlag(name):
+		case l.isKnownFlag(name):
+			keys = append(keys, l.flagKey(l.fieldKey(name)))
+		default:
+			keys = append(keys, name)
+		}
+	}
+
+	return keys
 }
 
 func (l *FlagLoader) Get(fieldSetKey, fieldKey string) (string, bool) {
@@ -64,7 +162,13 @@ func (l *FlagLoader) GetMap(fieldSetKey string, fieldKeys []string) map[string]s
 }
 
 func (l *FlagLoader) HelpString(fieldSetKey, fieldKey string) string {
-	return fmt.Sprintf("Flag argument: '--%s'", l.flagKey(fmt.Sprintf("%s_%s", fieldSetKey, fieldKey)))
+	helpString := fmt.Sprintf("Flag argument: '--%s'", l.flagKey(fmt.Sprintf("%s_%s", fieldSetKey, fieldKey)))
+
+	if field, found := l.fields[fmt.Sprintf("%s_%s", fieldSetKey, fieldKey)]; found && field.FlagShorthand != "" {
+		helpString = fmt.Sprintf("%s (shorthand: '-%s')", helpString, field.FlagShorthand)
+	}
+
+	return helpString
 }
 
 func (l *FlagLoader) flagKey(key string) string {
@@ -78,67 +182,276 @@ func (l *FlagLoader) flagKey(key string) string {
 	return strings.ToLower(flagKey)
 }
 
+func (l *FlagLoader) args() []string {
+	if len(l.OverrideLookup) > 0 {
+		return l.OverrideLookup
+	}
+
+	if len(os.Args) < 2 {
+		return nil
+	}
+
+	return os.Args[1:]
+}
+
 func (l *FlagLoader) flagValues() map[string]string {
-	values := map[string]string{}
+	if l.prepared != nil {
+		return l.prepared.values
+	}
 
-	var args []string
+	return l.parsedFlagValues(l.parse())
+}
 
-	if len(l.OverrideLookup) > 0 {
-		args = l.OverrideLookup
-	} else {
-		args = os.Args[1:]
+// parsedFlagValues joins repeated list field flag values, and selects the last value of other repeated flags.
+func (l *FlagLoader) parsedFlagValues(parsed flagParseResult) map[string]string {
+	values := make(map[string]string, len(parsed.values))
+
+	for key, flagValues := range parsed.values {
+		if field, found := l.fields[key]; found && isListFieldType(field.FieldType) {
+			values[key] = strings.Join(flagValues, listFieldDelimiter(field))
+		} else {
+			values[key] = flagValues[len(flagValues)-1]
+		}
 	}
 
-	if len(args) < 1 {
-		return values
+	return values
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type flagParseResult struct {
+	values map[string][]string
+	// names lists the flag names as given, with shorthands resolved to their field key
+	names []string
+	// positionalArgs lists the arguments that are neither flags nor flag values
+	positionalArgs []string
+	errs           []error
+	commandSeen    bool
+}
+
+func (l *FlagLoader) parse() flagParseResult {
+	if l.prepared != nil {
+		return l.prepared.result
 	}
 
-	argIdx := 0
-	parseArgs := true
+	result := flagParseResult{values: map[string][]string{}}
+	args := l.args()
 
-	for parseArgs && argIdx < len(args) {
+	for argIdx := 0; argIdx < len(args); argIdx++ {
 		arg := args[argIdx]
 
 		switch {
+		case arg == "--":
+			return result
 		case strings.HasPrefix(arg, "--"):
-			arg = arg[2:]
-		case strings.HasPrefix(arg, "-"):
-			arg = arg[1:]
+			argIdx += l.parseLongFlag(arg[2:], args[argIdx+1:], &result)
+		case strings.HasPrefix(arg, "-") && len(arg) > 1 && !isNegativeNumber(arg):
+			argIdx += l.parseShortFlag(arg[1:], args[argIdx+1:], &result)
 		default:
-			argIdx++
-			continue
+			if l.command != "" && len(result.positionalArgs) < 1 && arg == l.command {
+				result.commandSeen = true
+			}
+
+			result.positionalArgs = append(result.positionalArgs, arg)
 		}
+	}
 
-		flagKey := ""
-		flagValue := ""
+	return result
+}
 
-		if splitIndex := strings.Index(arg, "="); splitIndex > -1 {
-			flagKey = arg[:splitIndex]
-			flagValue = arg[splitIndex+1:]
-			values[flagKey] = flagValue
-			argIdx++
+// parseLongFlag parses a '--key' flag, returning the number of following arguments consumed as the flag value.
+func (l *FlagLoader) parseLongFlag(arg string, remaining []string, result *flagParseResult) int {
+	if splitIndex := strings.Index(arg, "="); splitIndex > -1 {
+		l.addFlagValue(arg[:splitIndex], arg[splitIndex+1:], result)
 
-			continue
-		}
+		return 0
+	}
+
+	if key := strings.TrimPrefix(arg, "no-"); key != arg && l.isBoolFlag(l.fieldKey(key)) && !l.isKnownFlag(arg) {
+		l.addFlagValue(key, "false", result)
+
+		return 0
+	}
+
+	return l.parseFlagWithoutValue(arg, "--"+arg, remaining, result)
+}
 
-		flagKey = arg
+// parseShortFlag parses a flag with a single dash, which may be a shorthand flag ('-p'), a shorthand with an attached
+// value ('-p8080'), a group of boolean shorthand flags ('-abc'), or a long flag name ('-log_level').
+func (l *FlagLoader) parseShortFlag(arg string, remaining []string, result *flagParseResult) int {
+	name, value, hasValue := strings.Cut(arg, "=")
 
-		if argIdx+1 < len(args) {
-			nextArg := args[argIdx+1]
+	if utf8.RuneCountInString(name) == 1 {
+		if hasValue {
+			l.addFlagValue(l.shorthandKey(name), value, result)
 
-			if !strings.HasPrefix(nextArg, "--") && !strings.HasPrefix(nextArg, "-") {
-				values[flagKey] = nextArg
-				argIdx += 2
+			return 0
+		}
 
-				continue
+		return l.parseFlagWithoutValue(l.shorthandKey(name), "-"+name, remaining, result)
+	}
+
+	if !hasValue && !l.isKnownFlag(name) {
+		if l.isBoolShorthandGroup(name) {
+			for _, shorthand := range name {
+				l.addFlagValue(l.shorthands[string(shorthand)], "true", result)
 			}
+
+			return 0
 		}
 
-		values[flagKey] = "true"
-		argIdx++
+		first, size := utf8.DecodeRuneInString(name)
+		if key, found := l.shorthands[string(first)]; found && !l.isBoolFlag(key) {
+			l.addFlagValue(key, name[size:], result)
 
-		continue
+			return 0
+		}
 	}
 
-	return values
+	if hasValue {
+		l.addFlagValue(name, value, result)
+
+		return 0
+	}
+
+	return l.parseFlagWithoutValue(name, "-"+name, remaining, result)
+}
+
+// parseFlagWithoutValue handles a flag without an attached '=value', consuming the next argument as the flag value
+// when appropriate. Boolean flags only consume an explicit 'true' or 'false' argument.
+func (l *FlagLoader) parseFlagWithoutValue(name, flag string, remaining []string, result *flagParseResult) int {
+	key := l.fieldKey(name)
+
+	var next string
+	if len(remaining) > 0 {
+		next = remaining[0]
+	}
+
+	switch {
+	case l.isBoolFlag(key) || slices.Contains(reservedBoolFlags, name):
+		if next == "true" || next == "false" {
+			l.addFlagValue(name, next, result)
+
+			return 1
+		}
+
+		l.addFlagValue(name, "true", result)
+
+		return 0
+	case len(remaining) > 0 && next != "--" && (!strings.HasPrefix(next, "-") || isNegativeNumber(next)):
+		l.addFlagValue(name, next, result)
+
+		return 1
+	case l.isKnownFlag(name):
+		result.errs = append(result.errs, fmt.Errorf("flag '%s' requires a value", flag))
+
+		return 0
+	default:
+		l.addFlagValue(name, "true", result)
+
+		return 0
+	}
+}
+
+func (l *FlagLoader) addFlagValue(name, value string, result *flagParseResult) {
+	key := l.fieldKey(name)
+
+	result.names = append(result.names, name)
+
+	if field, found := l.fields[key]; found && field.Command != "" {
+		if field.Command != l.command {
+			result.errs = append(result.errs, fmt.Errorf(
+				"flag '%s' is only available for command '%s'", flagDisplayName(name), field.Command,
+			))
+
+			return
+		}
+
+		if !result.commandSeen {
+			result.errs = append(result.errs, fmt.Errorf(
+				"flag '%s' must follow command '%s'", flagDisplayName(name), field.Command,
+			))
+
+			return
+		}
+	}
+
+	result.values[key] = append(result.values[key], value)
+}
+
+// fieldKey resolves a flag name to its '<field-set>_<field>' key, removing the loader key prefix and resolving
+// shorthands.
+func (l *FlagLoader) fieldKey(name string) string {
+	if key, found := l.shorthands[name]; found {
+		return key
+	}
+
+	if l.KeyPrefix != "" {
+		return strings.TrimPrefix(name, strings.ToLower(l.KeyPrefix)+"_")
+	}
+
+	return name
+}
+
+func (l *FlagLoader) shorthandKey(shorthand string) string {
+	if key, found := l.shorthands[shorthand]; found {
+		return key
+	}
+
+	return shorthand
+}
+
+func (l *FlagLoader) isKnownFlag(name string) bool {
+	_, found := l.fields[l.fieldKey(name)]
+
+	return found
+}
+
+func (l *FlagLoader) isBoolFlag(key string) bool {
+	field, found := l.fields[key]
+
+	return found && field.FieldType == Bool
+}
+
+func (l *FlagLoader) isBoolShorthandGroup(name string) bool {
+	for _, shorthand := range name {
+		key, found := l.shorthands[string(shorthand)]
+		if !found || !l.isBoolFlag(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isReservedFlag(name string) bool {
+	for _, reservedFlag := range reservedFlags {
+		if name == reservedFlag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNegativeNumber(arg string) bool {
+	if !strings.HasPrefix(arg, "-") {
+		return false
+	}
+
+	_, err := strconv.ParseFloat(arg, 64)
+
+	return err == nil
+}
+
+func flagDisplayName(name string) string {
+	if utf8.RuneCountInString(name) == 1 {
+		return "-" + name
+	}
+
+	return "--" + name
+}
+
+func isListFieldType(fieldType string) bool {
+	return strings.HasPrefix(fieldType, "[]")
 }
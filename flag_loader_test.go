@@ -2,6 +2,7 @@ package bconf_test
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
@@ -189,3 +190,95 @@ func TestFlagLoaderWithKeyPrefix(t *testing.T) {
 		t.Errorf("unexpected value for session_key from loader clone: '%s'", cloneSessionKeyLookup)
 	}
 }
+
+func TestFlagLoaderParsing(t *testing.T) {
+	l := bconf.NewFlagLoader()
+	l.SetFields([]bconf.LoaderField{
+		{FieldSetKey: "api", FieldKey: "offset", FieldType: bconf.Int},
+		{FieldSetKey: "api", FieldKey: "port", FieldType: bconf.Int, FlagShorthand: "p"},
+		{FieldSetKey: "api", FieldKey: "hosts", FieldType: bconf.Strings, FlagShorthand: "H"},
+		{FieldSetKey: "log", FieldKey: "color", FieldType: bconf.Bool, FlagShorthand: "c"},
+		{FieldSetKey: "log", FieldKey: "verbose", FieldType: bconf.Bool, FlagShorthand: "v"},
+		{FieldSetKey: "log", FieldKey: "json", FieldType: bconf.Bool},
+		{FieldSetKey: "log", FieldKey: "level", FieldType: bconf.String},
+	})
+
+	l.OverrideLookup = []string{
+		"serve",
+		"--api_offset", "-5",
+		"-p", "8080",
+		"--api_hosts=a.example.com",
+		"-H", "b.example.com",
+		"-cv",
+		"--no-log_json",
+		"--log_level", "debug",
+		"--log_level=error",
+		"--",
+		"--log_level=info",
+	}
+
+	expectedValues := map[string]string{
+		"api_offset":  "-5",
+		"api_port":    "8080",
+		"api_hosts":   "a.example.com,b.example.com",
+		"log_color":   "true",
+		"log_verbose": "true",
+		"log_json":    "false",
+		"log_level":   "error",
+	}
+
+	for key, expectedValue := range expectedValues {
+		fieldSetKey, fieldKey, _ := strings.Cut(key, "_")
+
+		value, found := l.Get(fieldSetKey, fieldKey)
+		if !found {
+			t.Errorf("expected value for flag '%s'", key)
+			continue
+		}
+
+		if value != expectedValue {
+			t.Errorf("unexpected value for flag '%s': '%s', expected '%s'", key, value, expectedValue)
+		}
+	}
+
+	if errs := l.Errors(); len(errs) > 0 {
+		t.Errorf("unexpected flag errors: %v", errs)
+	}
+
+	if helpString := l.HelpString("api", "port"); !strings.Contains(helpString, "'-p'") {
+		t.Errorf("expected shorthand in help string: '%s'", helpString)
+	}
+
+	l.OverrideLookup = []string{"-p8081", "--log_verbose", "serve", "--api_offset"}
+
+	if port, _ := l.Get("api", "port"); port != "8081" {
+		t.Errorf("unexpected value for attached shorthand value: '%s'", port)
+	}
+
+	if verbose, _ := l.Get("log", "verbose"); verbose != "true" {
+		t.Errorf("unexpected value for boolean flag followed by positional argument: '%s'", verbose)
+	}
+
+	if errs := l.Errors(); len(errs) != 1 {
+		t.Errorf("expected a single error for flag missing a value, found: %v", errs)
+	}
+}
+
+func TestFlagLoaderSeenKeys(t *testing.T) {
+	l := bconf.NewFlagLoaderWithKeyPrefix("myapp")
+	l.SetFields([]bconf.LoaderField{
+		{FieldSetKey: "server", FieldKey: "port", FieldType: bconf.Int, FlagShorthand: "p"},
+	})
+	l.OverrideLookup = []string{"--sever_port", "8080", "-p", "80", "--myapp_server_host", "localhost", "--help", "-x"}
+
+	seenKeys := slices.Sorted(slices.Values(l.SeenKeys()))
+	expectedKeys := []string{"myapp_server_host", "myapp_server_port", "sever_port", "x"}
+
+	if !slices.Equal(seenKeys, expectedKeys) {
+		t.Errorf("unexpected seen keys %v, expected %v", seenKeys, expectedKeys)
+	}
+
+	if errs := l.Errors(); len(errs) > 0 {
+		t.Errorf("unexpected errors for unknown flags outside of strict mode: %v", errs)
+	}
+}
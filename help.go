@@ -0,0 +1,500 @@
+package bconf
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	// HelpGroupByStatus groups help output fields into required, conditionally required, and optional sections
+	HelpGroupByStatus = "status"
+	// HelpGroupByFieldSet groups help output fields by field-set
+	HelpGroupByFieldSet = "field_set"
+	// HelpGroupByFieldSetGroup groups help output fields by field-set group name
+	HelpGroupByFieldSetGroup = "field_set_group"
+
+	defaultHelpWidth = 100
+
+	ansiBold  = "\033[1m"
+	ansiCyan  = "\033[36m"
+	ansiReset = "\033[0m"
+)
+
+// HelpOptions configures the help output of an AppConfig, and is provided with the WithHelpOptions config option.
+type HelpOptions struct {
+	// Renderer renders the help output (default: TextHelpRenderer)
+	Renderer HelpRenderer
+	// Writer receives the help output printed for the help flag (default: os.Stdout)
+	Writer io.Writer
+	// Width is the maximum line width (default: the width of the terminal the help output is written to, the COLUMNS
+	// environment variable, or 100 characters)
+	Width int
+	// Color enables ANSI colors in the default help renderer
+	Color bool
+	// GroupBy selects how fields are grouped: HelpGroupByStatus, HelpGroupByFieldSet, or HelpGroupByFieldSetGroup
+	// (default: HelpGroupByFieldSetGroup when field-set groups are registered, otherwise HelpGroupByStatus)
+	GroupBy string
+	// HideAppFields hides the 'app' field-set fields
+	HideAppFields bool
+	// HideInternalFields hides fields marked as internal
+	HideInternalFields bool
+}
+
+// HelpRenderer renders application help output.
+type HelpRenderer interface {
+	RenderHelp(writer io.Writer, help HelpData) error
+}
+
+// HelpData describes the application help output provided to a HelpRenderer.
+type HelpData struct {
+	// Usage is the application name, followed by the command name for command help output
+	Usage       string
+	Description string
+	Profile     string
+	Commands    []HelpCommand
+	Sections    []HelpSection
+	Width       int
+	Color       bool
+}
+
+type HelpCommand struct {
+	Name        string
+	Description string
+}
+
+type HelpSection struct {
+	Title       string
+	Description string
+	Fields      []HelpField
+}
+
+type HelpField struct {
+	Key         string
+	Type        string
+	Description string
+	// Details lists accepted values, default values, loader keys, and load condition dependencies
+	Details     []string
+	Required    bool
+	Conditional bool
+	Sensitive   bool
+	Internal    bool
+}
+
+// TextHelpRenderer is the default HelpRenderer, rendering plain text help output with optional ANSI colors.
+type TextHelpRenderer struct{}
+
+func (r TextHelpRenderer) RenderHelp(writer io.Writer, help HelpData) error {
+	builder := strings.Builder{}
+	width := help.Width
+
+	if width < 1 {
+		width = defaultHelpWidth
+	}
+
+	builder.WriteString(fmt.Sprintf("Usage of '%s':\n", help.Usage))
+
+	if help.Description != "" && len(help.Description) > width {
+		wrapStringForBuilder(help.Description, &builder, width, "")
+	} else if help.Description != "" {
+		builder.WriteString(fmt.Sprintf("%s\n\n", help.Description))
+	}
+
+	if help.Profile != "" {
+		builder.WriteString(fmt.Sprintf("Active profile: '%s'\n\n", help.Profile))
+	}
+
+	if len(help.Commands) > 0 {
+		builder.WriteString(colorize(help.Color, ansiBold, "Commands:") + "\n")
+
+		for _, command := range help.Commands {
+			builder.WriteString(fmt.Sprintf("\t%s\n", colorize(help.Color, ansiCyan, command.Name)))
+
+			if command.Description != "" {
+				builder.WriteString(fmt.Sprintf("\t\t%s\n", command.Description))
+			}
+		}
+
+		builder.WriteString("\n")
+	}
+
+	spaceBuffer := "\t\t"
+
+	for _, section := range help.Sections {
+		builder.WriteString(colorize(help.Color, ansiBold, section.Title+":") + "\n")
+
+		if section.Description != "" && len(section.Description) > width {
+			wrapStringForBuilder(section.Description, &builder, width, "")
+		} else if section.Description != "" {
+			builder.WriteString(fmt.Sprintf("%s\n", section.Description))
+		}
+
+		for _, field := range section.Fields {
+			builder.WriteString(fmt.Sprintf("\t%s %s\n", colorize(help.Color, ansiCyan, field.Key), field.Type))
+
+			if field.Description != "" {
+				builder.WriteString(spaceBuffer)
+
+				if len(spaceBuffer)+len(field.Description) > width {
+					wrapStringForBuilder(field.Description, &builder, width, spaceBuffer)
+				} else {
+					builder.WriteString(fmt.Sprintf("%s\n", field.Description))
+				}
+			}
+
+			for _, detail := range field.Details {
+				builder.WriteString(fmt.Sprintf("%s%s\n", spaceBuffer, detail))
+			}
+		}
+	}
+
+	if _, err := io.WriteString(writer, builder.String()); err != nil {
+		return fmt.Errorf("problem writing help output: %w", err)
+	}
+
+	return nil
+}
+
+// NewTemplateHelpRenderer returns a HelpRenderer executing a text/template with HelpData. Templates may use the 'wrap'
+// function ('{{ wrap .Description .Width "\t\t" }}') to wrap text to a line width with an indentation prefix.
+func NewTemplateHelpRenderer(text string) (HelpRenderer, error) {
+	helpTemplate, err := template.New("help").Funcs(template.FuncMap{"wrap": wrapString}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("problem parsing help template: %w", err)
+	}
+
+	return templateHelpRenderer{template: helpTemplate}, nil
+}
+
+type templateHelpRenderer struct {
+	template *template.Template
+}
+
+func (r templateHelpRenderer) RenderHelp(writer io.Writer, help HelpData) error {
+	if err := r.template.Execute(writer, help); err != nil {
+		return fmt.Errorf("problem executing help template: %w", err)
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (c *AppConfig) helpString(command string) string {
+	builder := strings.Builder{}
+
+	if err := c.helpRenderer().RenderHelp(&builder, c.helpData(command, &builder)); err != nil {
+		return err.Error()
+	}
+
+	return builder.String()
+}
+
+func (c *AppConfig) printHelpString() {
+	writer := c.helpOptions.Writer
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	if err := c.helpRenderer().RenderHelp(writer, c.helpData(c.selectedCommand, writer)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+func (c *AppConfig) helpRenderer() HelpRenderer {
+	if c.helpOptions.Renderer != nil {
+		return c.helpOptions.Renderer
+	}
+
+	return TextHelpRenderer{}
+}
+
+func (c *AppConfig) helpData(command string, writer io.Writer) HelpData {
+	help := HelpData{
+		Usage:       c.appValue("name"),
+		Description: c.appValue("description"),
+		Profile:     c.appValue("profile"),
+		Width:       c.helpWidth(writer),
+		Color:       c.helpOptions.Color,
+	}
+
+	if help.Usage == "" {
+		help.Usage = os.Args[0]
+	}
+
+	if selected := c.findCommand(command); selected != nil {
+		help.Usage = fmt.Sprintf("%s %s", help.Usage, selected.name)
+		help.Description = selected.description
+	} else {
+		for _, command := range c.commands {
+			help.Commands = append(help.Commands, HelpCommand{Name: command.name, Description: command.description})
+		}
+	}
+
+	help.Sections = c.helpSections(command)
+
+	return help
+}
+
+// helpWidth returns the configured help width, the width of the terminal the help output is written to, the terminal
+// width from the COLUMNS environment variable, or the default help width.
+func (c *AppConfig) helpWidth(writer io.Writer) int {
+	if c.helpOptions.Width > 0 {
+		return c.helpOptions.Width
+	}
+
+	if file, ok := writer.(*os.File); ok {
+		if columns, ok := terminalWidth(file); ok {
+			return columns
+		}
+	}
+
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+
+	return defaultHelpWidth
+}
+
+func (c *AppConfig) helpSections(command string) []HelpSection {
+	fields := c.fields(command)
+	keys := slices.Sorted(func(yield func(string) bool) {
+		for key := range fields {
+			if !yield(key) {
+				return
+			}
+		}
+	})
+
+	sections := []HelpSection{}
+	sectionIndexes := map[string]int{}
+
+	addField := func(title, description string, field HelpField) {
+		idx, found := sectionIndexes[title]
+		if !found {
+			idx = len(sections)
+			sectionIndexes[title] = idx
+			sections = append(sections, HelpSection{Title: title, Description: description})
+		}
+
+		sections[idx].Fields = append(sections[idx].Fields, field)
+	}
+
+	groupBy := c.helpOptions.GroupBy
+	if groupBy == "" && c.fieldSetGroups.registered() {
+		groupBy = HelpGroupByFieldSetGroup
+	}
+
+	switch groupBy {
+	case HelpGroupByFieldSet:
+		for _, fieldSet := range c.orderedFieldSets {
+			for _, key := range keys {
+				if entry := fields[key]; entry.fieldSetKey == fieldSet.Key && c.showHelpField(key, entry.field) {
+					addField(fmt.Sprintf("%s Configuration", fieldSet.Key), "", c.helpField(key, entry))
+				}
+			}
+		}
+	case HelpGroupByFieldSetGroup:
+		for _, group := range c.fieldSetGroups.sorted() {
+			for _, fieldSet := range group.fieldSets {
+				for _, key := range keys {
+					if entry := fields[key]; entry.fieldSetKey == fieldSet.Key && c.showHelpField(key, entry.field) {
+						addField(fmt.Sprintf("%s Configuration", group.name), group.description, c.helpField(key, entry))
+					}
+				}
+			}
+		}
+	default:
+		for _, status := range []string{"Required", "Conditionally Required", "Optional"} {
+			for _, key := range keys {
+				entry := fields[key]
+				if !c.showHelpField(key, entry.field) {
+					continue
+				}
+
+				field := c.helpField(key, entry)
+
+				switch {
+				case status == "Required" && field.Required && !field.Conditional,
+					status == "Conditionally Required" && field.Required && field.Conditional,
+					status == "Optional" && !field.Required:
+					addField(fmt.Sprintf("%s Configuration", status), "", field)
+				}
+			}
+		}
+	}
+
+	return sections
+}
+
+func (c *AppConfig) showHelpField(key string, field *Field) bool {
+	switch {
+	case key == "app.name" || key == "app.description":
+		return false
+	case c.helpOptions.HideAppFields && strings.HasPrefix(key, "app."):
+		return false
+	case c.helpOptions.HideInternalFields && field.Internal:
+		return false
+	default:
+		return true
+	}
+}
+
+func (c *AppConfig) helpField(key string, entry *fieldEntry) HelpField {
+	field := entry.field
+
+	helpField := HelpField{
+		Key:         key,
+		Type:        field.Type,
+		Description: field.Description,
+		Required:    field.Required,
+		Conditional: len(entry.loadConditions) > 0,
+		Sensitive:   field.Sensitive,
+		Internal:    field.Internal,
+	}
+
+	if field.Deprecated != "" {
+		helpField.Details = append(helpField.Details, fmt.Sprintf("Deprecated: %s", field.Deprecated))
+	}
+
+	if len(field.Enumeration) > 0 {
+		helpField.Details = append(
+			helpField.Details, fmt.Sprintf("%s: %s", field.enumerationLabel(), field.enumerationString()),
+		)
+	}
+
+	if field.ListDelimiter != "" {
+		helpField.Details = append(helpField.Details, fmt.Sprintf("List delimiter: '%s'", field.ListDelimiter))
+	}
+
+	if field.Default != nil && field.Sensitive {
+		helpField.Details = append(helpField.Details, "Default value: '<sensitive-value>'")
+	} else if field.Default != nil {
+		helpField.Details = append(helpField.Details, fmt.Sprintf("Default value: '%v'", field.Default))
+	}
+
+	if field.DefaultGenerator != nil {
+		helpField.Details = append(helpField.Details, "Default value: <generated-at-run-time>")
+	}
+
+	for _, profile := range field.profiles() {
+		if field.Sensitive {
+			helpField.Details = append(
+				helpField.Details, fmt.Sprintf("Profile '%s' default value: '<sensitive-value>'", profile),
+			)
+		} else {
+			helpField.Details = append(helpField.Details, fmt.Sprintf(
+				"Profile '%s' default value: '%v'", profile, field.ProfileDefaults[profile],
+			))
+		}
+	}
+
+	for _, loader := range c.loaders {
+		if !field.loaderAllowed(loader.Name()) {
+			continue
+		}
+
+		if helpString := loader.HelpString(entry.fieldSetKey, field.Key); helpString != "" {
+			helpField.Details = append(helpField.Details, helpString)
+		}
+	}
+
+	for _, condition := range entry.loadConditions {
+		dependencies := condition.FieldDependencies()
+
+		if len(dependencies) < 1 {
+			helpField.Details = append(helpField.Details, "Loading depends on: <custom-load-condition-function>")
+
+			continue
+		}
+
+		locations := make([]string, len(dependencies))
+
+		for idx, dependency := range dependencies {
+			locations[idx] = fmt.Sprintf("'%s.%s'", dependency.FieldSetKey, dependency.FieldKey)
+		}
+
+		helpField.Details = append(
+			helpField.Details, fmt.Sprintf("Loading depends on field(s): %s", strings.Join(locations, ", ")),
+		)
+	}
+
+	return helpField
+}
+
+type fieldEntry struct {
+	fieldSetKey    string
+	field          *Field
+	loadConditions LoadConditions
+}
+
+// fields returns the shared fields and the fields of the provided command, keyed by '<field-set>.<field>'.
+func (c *AppConfig) fields(command string) map[string]*fieldEntry {
+	fields := map[string]*fieldEntry{}
+
+	for fieldSetKey, fieldSet := range c.fieldSets {
+		if !c.fieldSetInCommandScope(fieldSetKey, command) {
+			continue
+		}
+
+		for _, field := range fieldSet.fieldMap {
+			entry := fieldEntry{field: field, fieldSetKey: fieldSetKey}
+
+			if len(fieldSet.LoadConditions) > 0 || len(field.LoadConditions) > 0 {
+				entry.loadConditions = append(slices.Clone(fieldSet.LoadConditions), field.LoadConditions...)
+			}
+
+			fields[fmt.Sprintf("%s.%s", fieldSetKey, field.Key)] = &entry
+		}
+	}
+
+	return fields
+}
+
+func colorize(enabled bool, code, value string) string {
+	if !enabled {
+		return value
+	}
+
+	return code + value + ansiReset
+}
+
+func wrapString(content string, maxCharLength int, spaceBuffer string) string {
+	builder := strings.Builder{}
+
+	builder.WriteString(spaceBuffer)
+	wrapStringForBuilder(content, &builder, maxCharLength, spaceBuffer)
+
+	return builder.String()
+}
+
+func wrapStringForBuilder(content string, builder *strings.Builder, maxCharLength int, spaceBuffer string) {
+	maxCharLength -= len(spaceBuffer)
+
+	words := strings.Split(content, " ")
+	chunkLen := 0
+
+	for _, word := range words {
+		wordLen := len(word) + 1
+
+		if chunkLen+wordLen > maxCharLength {
+			builder.WriteString("\n")
+			builder.WriteString(spaceBuffer)
+			fmt.Fprintf(builder, "%s ", word)
+
+			chunkLen = 0
+
+			continue
+		}
+
+		fmt.Fprintf(builder, "%s ", word)
+
+		chunkLen += wordLen
+	}
+
+	builder.WriteString("\n")
+}
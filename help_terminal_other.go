@@ -0,0 +1,10 @@
+//go:build !(darwin || freebsd || linux || netbsd || openbsd)
+
+package bconf
+
+import "os"
+
+// terminalWidth reports that the terminal width is unknown on platforms without terminal size support.
+func terminalWidth(_ *os.File) (int, bool) {
+	return 0, false
+}
@@ -0,0 +1,28 @@
+//go:build darwin || freebsd || linux || netbsd || openbsd
+
+package bconf
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// terminalWidth returns the column width of the terminal a file is attached to.
+func terminalWidth(file *os.File) (int, bool) {
+	var size struct {
+		rows    uint16
+		columns uint16
+		xPixels uint16
+		yPixels uint16
+	}
+
+	_, _, errno := syscall.Syscall(
+		syscall.SYS_IOCTL, file.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&size)),
+	)
+	if errno != 0 || size.columns < 1 {
+		return 0, false
+	}
+
+	return int(size.columns), true
+}
@@ -0,0 +1,107 @@
+package bconf_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigHelpOptions(t *testing.T) {
+	args := os.Args
+	os.Args = []string{"testapp"}
+
+	t.Cleanup(func() { os.Args = args })
+
+	newAppConfig := func(options bconf.HelpOptions) *bconf.AppConfig {
+		appConfig := bconf.NewAppConfig(
+			"testapp",
+			"testapp description",
+			bconf.WithEnvironmentLoader(""),
+			bconf.WithHelpOptions(options),
+		)
+
+		appConfig.AddFieldSetGroup("server", bconf.FieldSets{
+			bconf.FSB("api").Fields(
+				bconf.FB("host", bconf.String).Default("localhost").Description("api host name").C(),
+				bconf.FB("debug_token", bconf.String).Default("token").Internal().C(),
+			).C(),
+			bconf.FSB("grpc").Fields(bconf.FB("port", bconf.Int).Default(9090).C()).C(),
+		})
+
+		if errs := appConfig.Load(); len(errs) > 0 {
+			t.Fatalf("unexpected error(s) loading app config: %v", errs)
+		}
+
+		return appConfig
+	}
+
+	helpString := newAppConfig(bconf.HelpOptions{GroupBy: bconf.HelpGroupByStatus}).HelpString()
+
+	for _, content := range []string{"Optional Configuration:\n", "\tapp.version string\n", "\tapi.debug_token string\n"} {
+		if !strings.Contains(helpString, content) {
+			t.Errorf("expected status grouped help string to contain '%s', found:\n%s", content, helpString)
+		}
+	}
+
+	helpString = newAppConfig(bconf.HelpOptions{}).HelpString()
+
+	for _, content := range []string{"app Configuration:\n\tapp.id string\n", "server Configuration:\n\tapi.debug_token"} {
+		if !strings.Contains(helpString, content) {
+			t.Errorf("expected default help string grouped by field-set group to contain '%s', found:\n%s", content, helpString)
+		}
+	}
+
+	helpString = newAppConfig(bconf.HelpOptions{HideAppFields: true, HideInternalFields: true}).HelpString()
+
+	if strings.Contains(helpString, "app.version") || strings.Contains(helpString, "api.debug_token") {
+		t.Errorf("expected app and internal fields to be hidden, found:\n%s", helpString)
+	}
+
+	helpString = newAppConfig(bconf.HelpOptions{GroupBy: bconf.HelpGroupByFieldSet}).HelpString()
+
+	if !strings.Contains(helpString, "api Configuration:\n\tapi.debug_token string") ||
+		!strings.Contains(helpString, "grpc Configuration:\n\tgrpc.port int") {
+		t.Errorf("expected help string grouped by field-set, found:\n%s", helpString)
+	}
+
+	helpString = newAppConfig(bconf.HelpOptions{GroupBy: bconf.HelpGroupByFieldSetGroup}).HelpString()
+
+	if strings.Count(helpString, "server Configuration:") != 1 || !strings.Contains(helpString, "\tgrpc.port int") {
+		t.Errorf("expected help string grouped by field-set group, found:\n%s", helpString)
+	}
+
+	helpString = newAppConfig(bconf.HelpOptions{GroupBy: bconf.HelpGroupByStatus, Color: true}).HelpString()
+
+	if !strings.Contains(helpString, "\033[1mOptional Configuration:\033[0m") {
+		t.Errorf("expected colored help string section titles, found:\n%s", helpString)
+	}
+
+	t.Setenv("COLUMNS", "14")
+
+	helpString = newAppConfig(bconf.HelpOptions{}).HelpString()
+
+	if !strings.Contains(helpString, "\t\tapi host \n\t\tname \n") {
+		t.Errorf("expected help string wrapped to terminal width, found:\n%s", helpString)
+	}
+
+	renderer, err := bconf.NewTemplateHelpRenderer(
+		"{{ .Usage }}{{ range .Sections }}|{{ .Title }}{{ range .Fields }} {{ .Key }}{{ end }}{{ end }}",
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating template help renderer: %s", err)
+	}
+
+	helpString = newAppConfig(
+		bconf.HelpOptions{Renderer: renderer, GroupBy: bconf.HelpGroupByStatus, HideAppFields: true},
+	).HelpString()
+
+	if expected := "testapp|Optional Configuration api.debug_token api.host grpc.port"; helpString != expected {
+		t.Errorf("unexpected template help string: expected '%s', found '%s'", expected, helpString)
+	}
+
+	if _, err := bconf.NewTemplateHelpRenderer("{{ .Usage "); err == nil {
+		t.Errorf("expected error creating template help renderer with an invalid template")
+	}
+}
@@ -0,0 +1,138 @@
+package bconf
+
+import "fmt"
+
+// Hooks defines functions run during the configuration load lifecycle, allowing cross-cutting behavior (e.g.
+// decrypting values, auditing value sources, or normalizing strings) to be added without changing how field-sets are
+// loaded. Hooks are registered with the WithHooks config option, and any hook may be left nil. Hooks may read the
+// AppConfig, but must not load, reload, or set field values, as described by AppConfig.
+type Hooks struct {
+	// BeforeFieldSetLoad runs before loader values are queried for a field-set whose load conditions are met. An
+	// error stops the field-set from loading.
+	BeforeFieldSetLoad func(fieldSetKey string) error
+	// AfterFieldSetLoad runs after a field-set has loaded without errors. An error fails the field-set load.
+	AfterFieldSetLoad func(fieldSetKey string) error
+	// TransformValue receives the raw string value found by a loader before it is parsed to the field type, returning
+	// the value to parse in its place. For loaders implementing TypedLoader, string values and string list elements
+	// are transformed, and other typed values (e.g. numbers and bools) are not.
+	TransformValue func(fieldSetKey, fieldKey, loaderName, value string) (string, error)
+	// AfterValidation runs after a loader value has been parsed and validated, before it is set on the field. An error
+	// rejects the value.
+	AfterValidation func(fieldSetKey, fieldKey, loaderName string, value any) error
+	// AfterFillStruct runs after an attached or provided config struct has been filled.
+	AfterFillStruct func(configStruct any) error
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+type hooks []Hooks
+
+func (h hooks) beforeFieldSetLoad(fieldSetKey string) error {
+	for _, hook := range h {
+		if hook.BeforeFieldSetLoad == nil {
+			continue
+		}
+
+		if err := hook.BeforeFieldSetLoad(fieldSetKey); err != nil {
+			return fmt.Errorf("field-set '%s' before load hook error: %w", fieldSetKey, err)
+		}
+	}
+
+	return nil
+}
+
+func (h hooks) afterFieldSetLoad(fieldSetKey string) error {
+	for _, hook := range h {
+		if hook.AfterFieldSetLoad == nil {
+			continue
+		}
+
+		if err := hook.AfterFieldSetLoad(fieldSetKey); err != nil {
+			return fmt.Errorf("field-set '%s' after load hook error: %w", fieldSetKey, err)
+		}
+	}
+
+	return nil
+}
+
+func (h hooks) transformValue(fieldSetKey, fieldKey, loaderName, value string) (string, error) {
+	for _, hook := range h {
+		if hook.TransformValue == nil {
+			continue
+		}
+
+		transformedValue, err := hook.TransformValue(fieldSetKey, fieldKey, loaderName, value)
+		if err != nil {
+			return "", fmt.Errorf("value transform hook error: %w", err)
+		}
+
+		value = transformedValue
+	}
+
+	return value, nil
+}
+
+// transformTypedValue applies the transform value hooks to a typed loader value when it is a string, and to each
+// string element when it is a list.
+func (h hooks) transformTypedValue(fieldSetKey, fieldKey, loaderName string, value any) (any, error) {
+	switch typedValue := value.(type) {
+	case string:
+		return h.transformValue(fieldSetKey, fieldKey, loaderName, typedValue)
+	case []any:
+		transformedValues := make([]any, len(typedValue))
+
+		for idx, element := range typedValue {
+			transformedValue, err := h.transformTypedValue(fieldSetKey, fieldKey, loaderName, element)
+			if err != nil {
+				return nil, err
+			}
+
+			transformedValues[idx] = transformedValue
+		}
+
+		return transformedValues, nil
+	case []string:
+		transformedValues := make([]string, len(typedValue))
+
+		for idx, element := range typedValue {
+			transformedValue, err := h.transformValue(fieldSetKey, fieldKey, loaderName, element)
+			if err != nil {
+				return nil, err
+			}
+
+			transformedValues[idx] = transformedValue
+		}
+
+		return transformedValues, nil
+	default:
+		return value, nil
+	}
+}
+
+func (h hooks) afterValidation(fieldSetKey, fieldKey, loaderName string, value any) error {
+	for _, hook := range h {
+		if hook.AfterValidation == nil {
+			continue
+		}
+
+		if err := hook.AfterValidation(fieldSetKey, fieldKey, loaderName, value); err != nil {
+			return fmt.Errorf("after validation hook error: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (h hooks) afterFillStruct(configStruct any) error {
+	for _, hook := range h {
+		if hook.AfterFillStruct == nil {
+			continue
+		}
+
+		if err := hook.AfterFillStruct(configStruct); err != nil {
+			return fmt.Errorf("after fill struct hook error: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package bconf_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+type HooksConfig struct {
+	bconf.ConfigStruct `bconf:"api"`
+	Host               string `bconf:"host"`
+	Token              string `bconf:"token"`
+}
+
+func TestAppConfigWithHooks(t *testing.T) {
+	t.Setenv("BCONF_HOOKS_TEST_API_HOST", "  Example.COM ")
+	t.Setenv("BCONF_HOOKS_TEST_API_TOKEN", "enc:terces")
+
+	events := []string{}
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_hooks_test"),
+		bconf.WithHooks(bconf.Hooks{
+			BeforeFieldSetLoad: func(fieldSetKey string) error {
+				events = append(events, fmt.Sprintf("before:%s", fieldSetKey))
+				return nil
+			},
+			AfterFieldSetLoad: func(fieldSetKey string) error {
+				events = append(events, fmt.Sprintf("after:%s", fieldSetKey))
+				return nil
+			},
+			TransformValue: func(_, _, _, value string) (string, error) {
+				return strings.ToLower(strings.TrimSpace(value)), nil
+			},
+		}),
+		bconf.WithHooks(bconf.Hooks{
+			TransformValue: func(_, fieldKey, _, value string) (string, error) {
+				encrypted, found := strings.CutPrefix(value, "enc:")
+				if fieldKey != "token" || !found {
+					return value, nil
+				}
+
+				decrypted := []rune(encrypted)
+				slices.Reverse(decrypted)
+
+				return string(decrypted), nil
+			},
+			AfterValidation: func(fieldSetKey, fieldKey, loaderName string, _ any) error {
+				events = append(events, fmt.Sprintf("set:%s.%s:%s", fieldSetKey, fieldKey, loaderName))
+				return nil
+			},
+			AfterFillStruct: func(configStruct any) error {
+				if hooksConfig, ok := configStruct.(*HooksConfig); ok {
+					events = append(events, fmt.Sprintf("fill:%s", hooksConfig.Host))
+				}
+
+				return nil
+			},
+		}),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).C(),
+		bconf.FB("token", bconf.String).Sensitive().C(),
+	).C())
+
+	hooksConfig := &HooksConfig{}
+	appConfig.AttachConfigStructs(hooksConfig)
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if hooksConfig.Host != "example.com" {
+		t.Errorf("unexpected transformed host value '%s', expected 'example.com'", hooksConfig.Host)
+	}
+
+	if hooksConfig.Token != "secret" {
+		t.Errorf("unexpected transformed token value '%s', expected 'secret'", hooksConfig.Token)
+	}
+
+	expectedEvents := []string{
+		"before:app",
+		"after:app",
+		"before:api",
+		"set:api.host:bconf_environment",
+		"set:api.token:bconf_environment",
+		"after:api",
+		"fill:example.com",
+	}
+
+	apiEvents := slices.DeleteFunc(slices.Clone(events), func(event string) bool {
+		return strings.HasPrefix(event, "set:app.")
+	})
+
+	// loader values are set in map order
+	if len(apiEvents) == len(expectedEvents) {
+		slices.Sort(apiEvents[3:5])
+	}
+
+	if !slices.Equal(apiEvents, expectedEvents) {
+		t.Errorf("unexpected hook events:\n%v\nexpected:\n%v", apiEvents, expectedEvents)
+	}
+}
+
+func TestAppConfigWithHooksErrors(t *testing.T) {
+	t.Setenv("BCONF_HOOKS_ERRORS_TEST_API_HOST", "example.com")
+
+	hookErr := errors.New("value rejected")
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_hooks_errors_test"),
+		bconf.WithHooks(bconf.Hooks{
+			AfterValidation: func(_, fieldKey, _ string, _ any) error {
+				if fieldKey == "host" {
+					return hookErr
+				}
+
+				return nil
+			},
+		}),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("host", bconf.String).C()).C())
+
+	errs := appConfig.Load()
+	if len(errs) != 1 || !errors.Is(errs[0], hookErr) {
+		t.Fatalf("expected after validation hook error loading app config, found: %v", errs)
+	}
+
+	fillErr := errors.New("fill rejected")
+
+	appConfig = bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithHooks(bconf.Hooks{
+			AfterFillStruct: func(_ any) error {
+				return fillErr
+			},
+		}),
+	)
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if err := appConfig.FillStruct(&struct{}{}); !errors.Is(err, fillErr) {
+		t.Fatalf("expected after fill struct hook error, found: %v", err)
+	}
+}
@@ -0,0 +1,213 @@
+package bconf
+
+import "slices"
+
+const (
+	// FieldSourceOverride identifies field values set with the AppConfig SetField method
+	FieldSourceOverride = "override"
+	// FieldSourceProfileDefault identifies field values from the active profile default
+	FieldSourceProfileDefault = "profile_default"
+	// FieldSourceDefault identifies field values from the field default
+	FieldSourceDefault = "default"
+	// FieldSourceGeneratedDefault identifies field values from the field default generator
+	FieldSourceGeneratedDefault = "generated_default"
+)
+
+// FieldSetInfo is a read-only description of a field-set registered with an AppConfig.
+type FieldSetInfo struct {
+	Key string
+	// Group is the name of the field-set group the field-set was added with
+	Group string
+	// Command is the name of the command the field-set belongs to, or empty for shared field-sets
+	Command string
+	// LoadDependencies lists the fields the field-set load conditions depend on
+	LoadDependencies FieldLocations
+	// Fields describes the field-set fields, ordered by field key
+	Fields []FieldInfo
+	// Conditional identifies field-sets with load conditions
+	Conditional bool
+}
+
+// Field returns the description of the field matching the provided field key.
+func (i FieldSetInfo) Field(fieldKey string) (FieldInfo, bool) {
+	idx := slices.IndexFunc(i.Fields, func(field FieldInfo) bool { return field.Key == fieldKey })
+	if idx < 0 {
+		return FieldInfo{}, false
+	}
+
+	return i.Fields[idx], true
+}
+
+// FieldInfo is a read-only description of a field registered with an AppConfig. Default, ProfileDefaults, and Value
+// are omitted for sensitive fields, except for values masked by FieldSetsWithMask.
+type FieldInfo struct {
+	// Default is the field default value, or the generated default value for fields with a default generator
+	Default any
+	// Value is the current field value, or nil when no value is set
+	Value           any
+	ProfileDefaults map[string]any
+	// LoaderKeys maps loader names to the key each loader reads the field value from (e.g. the environment
+	// variable name or flag name)
+	LoaderKeys  map[string]string
+	FieldSetKey string
+	Key         string
+	Type        string
+	Description string
+	// Deprecated contains the deprecation message of deprecated fields
+	Deprecated string
+	// Source identifies where the current field value came from: a loader name, one of the FieldSource constants, or
+	// empty when no value is set
+	Source        string
+	FlagShorthand string
+	Enumeration   []any
+	// LoadDependencies lists the fields the field and field-set load conditions depend on
+	LoadDependencies FieldLocations
+	Required         bool
+	Sensitive        bool
+	Internal         bool
+	// Conditional identifies fields with field or field-set load conditions
+	Conditional bool
+}
+
+// FieldSets returns read-only descriptions of the registered field-sets in registration order.
+func (c *AppConfig) FieldSets() []FieldSetInfo {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.fieldSetInfos(nil)
+}
+
+// FieldSetsWithMask returns the field-set descriptions returned by FieldSets, where the values of sensitive fields are
+// masked with the provided mask strategy rather than omitted, so values and sources describe the same configuration.
+func (c *AppConfig) FieldSetsWithMask(mask MaskStrategy) []FieldSetInfo {
+	if mask == nil {
+		mask = MaskFull()
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.fieldSetInfos(mask)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (c *AppConfig) fieldSetInfos(mask MaskStrategy) []FieldSetInfo {
+	groupNames := map[string]string{}
+
+	for _, group := range c.fieldSetGroups {
+		for _, fieldSet := range group.fieldSets {
+			groupNames[fieldSet.Key] = group.name
+		}
+	}
+
+	infos := make([]FieldSetInfo, len(c.orderedFieldSets))
+
+	for idx, fieldSet := range c.orderedFieldSets {
+		info := FieldSetInfo{
+			Key:              fieldSet.Key,
+			Group:            groupNames[fieldSet.Key],
+			Command:          c.fieldSetCommands[fieldSet.Key],
+			LoadDependencies: loadConditionDependencies(fieldSet.LoadConditions),
+			Conditional:      len(fieldSet.LoadConditions) > 0,
+		}
+
+		for _, fieldKey := range slices.Sorted(slices.Values(fieldSet.fieldKeys())) {
+			info.Fields = append(info.Fields, c.fieldInfo(fieldSet, fieldSet.fieldMap[fieldKey], mask))
+		}
+
+		infos[idx] = info
+	}
+
+	return infos
+}
+
+// keyedLoader is implemented by loaders that can report the key a field value is read from.
+type keyedLoader interface {
+	Key(fieldSetKey, fieldKey string) string
+}
+
+// fieldInfo describes a field, masking the value of a sensitive field when a mask strategy is provided.
+func (c *AppConfig) fieldInfo(fieldSet *FieldSet, field *Field, mask MaskStrategy) FieldInfo {
+	info := FieldInfo{
+		LoaderKeys:    map[string]string{},
+		FieldSetKey:   fieldSet.Key,
+		Key:           field.Key,
+		Type:          field.Type,
+		Description:   field.Description,
+		Deprecated:    field.Deprecated,
+		Source:        field.source(),
+		FlagShorthand: field.FlagShorthand,
+		Enumeration:   slices.Clone(field.Enumeration),
+		LoadDependencies: append(
+			loadConditionDependencies(fieldSet.LoadConditions), loadConditionDependencies(field.LoadConditions)...,
+		),
+		Required:    field.Required,
+		Sensitive:   field.Sensitive,
+		Internal:    field.Internal,
+		Conditional: len(fieldSet.LoadConditions) > 0 || len(field.LoadConditions) > 0,
+	}
+
+	if value, err := field.getValue(); err == nil && field.Sensitive && mask != nil {
+		info.Value = mask(exportString(exportValue(value, DurationFormatString), field.listDelimiter()))
+	}
+
+	if !field.Sensitive {
+		info.Default = cloneFieldValue(field.Default)
+		if info.Default == nil {
+			info.Default = cloneFieldValue(field.generatedDefault)
+		}
+
+		if value, err := field.getValue(); err == nil {
+			info.Value = cloneFieldValue(value)
+		}
+
+		for profile, value := range field.ProfileDefaults {
+			if info.ProfileDefaults == nil {
+				info.ProfileDefaults = map[string]any{}
+			}
+
+			info.ProfileDefaults[profile] = cloneFieldValue(value)
+		}
+	}
+
+	for _, loader := range c.loaders {
+		if !field.loaderAllowed(loader.Name()) {
+			continue
+		}
+
+		if keyed, ok := loader.(keyedLoader); ok {
+			info.LoaderKeys[loader.Name()] = keyed.Key(fieldSet.Key, field.Key)
+		}
+	}
+
+	return info
+}
+
+// source returns where the current field value came from, following the value priority of getValue.
+func (f *Field) source() string {
+	switch {
+	case f.overrideValue != nil:
+		return FieldSourceOverride
+	case len(f.fieldFound) > 0:
+		return f.fieldFound[len(f.fieldFound)-1]
+	case f.profile != "" && f.ProfileDefaults[f.profile] != nil:
+		return FieldSourceProfileDefault
+	case f.Default != nil:
+		return FieldSourceDefault
+	case f.generatedDefault != nil:
+		return FieldSourceGeneratedDefault
+	default:
+		return ""
+	}
+}
+
+func loadConditionDependencies(loadConditions LoadConditions) FieldLocations {
+	dependencies := FieldLocations{}
+
+	for _, condition := range loadConditions {
+		dependencies = append(dependencies, condition.FieldDependencies()...)
+	}
+
+	return dependencies
+}
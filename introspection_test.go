@@ -0,0 +1,106 @@
+package bconf_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigFieldSets(t *testing.T) {
+	t.Setenv("INTROSPECTION_API_HOST", "api.example.com")
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("introspection"),
+		bconf.WithFlagLoader(),
+		bconf.WithAppProfile("dev"),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).Default("localhost").Description("api host").C(),
+		bconf.FB("port", bconf.Int).Default(8080).ProfileDefault("dev", 8081).Enumeration(8080, 8081).C(),
+		bconf.FB("token", bconf.String).Default("secret").Sensitive().C(),
+		bconf.FB("timeout", bconf.Duration).C(),
+	).C())
+
+	appConfig.AddFieldSet(bconf.FSB("cache").LoadConditions(
+		bconf.LCB(func(_ bconf.FieldValueFinder) (bool, error) { return true, nil }).
+			AddFieldDependencies(bconf.FD("api", "host")).C(),
+	).Fields(bconf.FB("size", bconf.Int).Default(10).C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if err := appConfig.SetField("cache", "size", 20); err != nil {
+		t.Fatalf("unexpected error setting field: %s", err)
+	}
+
+	fieldSets := appConfig.FieldSets()
+
+	if len(fieldSets) != 3 || fieldSets[0].Key != "app" || fieldSets[1].Key != "api" || fieldSets[2].Key != "cache" {
+		t.Fatalf("unexpected field-sets: %v", fieldSets)
+	}
+
+	api := fieldSets[1]
+
+	host, found := api.Field("host")
+	if !found {
+		t.Fatalf("expected 'api.host' field info")
+	}
+
+	expectedHost := bconf.FieldInfo{
+		Default:     "localhost",
+		Value:       "api.example.com",
+		FieldSetKey: "api",
+		Key:         "host",
+		Type:        bconf.String,
+		Description: "api host",
+		Source:      bconf.LoaderNameEnvironment,
+		LoaderKeys: map[string]string{
+			bconf.LoaderNameEnvironment: "INTROSPECTION_API_HOST",
+			bconf.LoaderNameFlag:        "api_host",
+		},
+		LoadDependencies: bconf.FieldLocations{},
+	}
+
+	if !reflect.DeepEqual(host, expectedHost) {
+		t.Errorf("unexpected 'api.host' field info:\nexpected: %+v\nfound:    %+v", expectedHost, host)
+	}
+
+	if port, _ := api.Field("port"); port.Value != 8081 || port.Source != bconf.FieldSourceProfileDefault ||
+		len(port.Enumeration) != 2 || port.ProfileDefaults["dev"] != 8081 {
+		t.Errorf("unexpected 'api.port' field info: %+v", port)
+	}
+
+	if token, _ := api.Field("token"); !token.Sensitive || token.Default != nil || token.Value != nil {
+		t.Errorf("unexpected 'api.token' field info, sensitive values should be omitted: %+v", token)
+	}
+
+	maskedFieldSets := appConfig.FieldSetsWithMask(bconf.MaskLastFour())
+
+	if token, _ := maskedFieldSets[1].Field("token"); token.Value != "**cret" || token.Default != nil {
+		t.Errorf("unexpected masked 'api.token' field info: %+v", token)
+	}
+
+	if timeout, _ := api.Field("timeout"); timeout.Value != nil || timeout.Source != "" {
+		t.Errorf("unexpected 'api.timeout' field info for unset field: %+v", timeout)
+	}
+
+	cache := fieldSets[2]
+
+	if !cache.Conditional || len(cache.LoadDependencies) != 1 || cache.Group != "cache" {
+		t.Errorf("unexpected 'cache' field-set info: %+v", cache)
+	}
+
+	if size, _ := cache.Field("size"); size.Value != 20 || size.Source != bconf.FieldSourceOverride ||
+		!size.Conditional {
+		t.Errorf("unexpected 'cache.size' field info: %+v", size)
+	}
+
+	if _, found := cache.Field("missing"); found {
+		t.Errorf("unexpected field info for missing field")
+	}
+}
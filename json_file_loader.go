@@ -1,9 +1,13 @@
 package bconf
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 )
@@ -27,15 +31,32 @@ func NewJSONFileLoaderWithAttributes(decoder JSONUnmarshal, filePaths ...string)
 	}
 }
 
+// JSONFileLoader loads field values from JSON files, where earlier file paths take precedence over later file paths.
+// Files that cannot be read or decoded are reported through Errors, except for missing optional files and missing
+// profile-specific files.
 type JSONFileLoader struct {
-	Decoder   JSONUnmarshal
+	Decoder JSONUnmarshal
+	// Profile, when set, adds a profile-specific file path (e.g. 'config.prod.json') ahead of each file path, giving
+	// profile-specific values precedence over base values
+	Profile   string
 	FilePaths []string
+	// OptionalFilePaths lists file paths in FilePaths that are not required to exist
+	OptionalFilePaths []string
+	// prepared caches the decoded files between Prepare and Finish
+	prepared *jsonFileMaps
+}
+
+type jsonFileMaps struct {
+	fileMaps []map[string]any
+	errs     []error
 }
 
 func (l *JSONFileLoader) Clone() *JSONFileLoader {
 	clone := *l
 
 	clone.FilePaths = slices.Clone(l.FilePaths)
+	clone.OptionalFilePaths = slices.Clone(l.OptionalFilePaths)
+	clone.prepared = nil
 
 	return &clone
 }
@@ -45,7 +66,29 @@ func (l *JSONFileLoader) CloneLoader() Loader {
 }
 
 func (l *JSONFileLoader) Name() string {
-	return "bconf_jsonfile"
+	return LoaderNameJSONFile
+}
+
+func (l *JSONFileLoader) SetProfile(profile string) {
+	changed := l.Profile != profile
+
+	l.Profile = profile
+
+	if changed && l.prepared != nil {
+		l.Prepare()
+	}
+}
+
+// Prepare reads and decodes the JSON files once, caching them for lookups until Finish is called.
+func (l *JSONFileLoader) Prepare() {
+	fileMaps, errs := l.readFileMaps()
+
+	l.prepared = &jsonFileMaps{fileMaps: fileMaps, errs: errs}
+}
+
+// Finish releases the JSON files cached by Prepare.
+func (l *JSONFileLoader) Finish() {
+	l.prepared = nil
 }
 
 func (l *JSONFileLoader) Get(fieldSetKey, fieldKey string) (string, bool) {
@@ -77,6 +120,48 @@ func (l *JSONFileLoader) GetMap(fieldSetKey string, fieldKeys []string) map[stri
 	return values
 }
 
+// GetTypedMap returns the decoded JSON values found for the field keys, allowing numbers, bools, and arrays to be
+// converted to field values without a string round-trip.
+func (l *JSONFileLoader) GetTypedMap(fieldSetKey string, fieldKeys []string) map[string]any {
+	values := map[string]any{}
+
+	maps := l.fileMaps()
+
+	for _, fieldKey := range fieldKeys {
+		if value, found := findRawValueInMaps(fieldSetKey, fieldKey, maps); found {
+			values[fieldKey] = value
+		}
+	}
+
+	return values
+}
+
+func (l *JSONFileLoader) Key(fieldSetKey, fieldKey string) string {
+	return fmt.Sprintf("%s.%s", fieldSetKey, fieldKey)
+}
+
+// SeenKeys returns the '<field-set>.<field>' attribute paths found in the JSON files. Top-level attributes that are not
+// JSON objects are returned as-is.
+func (l *JSONFileLoader) SeenKeys() []string {
+	keys := []string{}
+
+	for _, fileMap := range l.fileMaps() {
+		for fieldSetKey, fieldSetAny := range fileMap {
+			fieldSetMap, ok := fieldSetAny.(map[string]any)
+			if !ok {
+				keys = append(keys, fieldSetKey)
+				continue
+			}
+
+			for fieldKey := range fieldSetMap {
+				keys = append(keys, l.Key(fieldSetKey, fieldKey))
+			}
+		}
+	}
+
+	return keys
+}
+
 func (l *JSONFileLoader) HelpString(fieldSetKey, fieldKey string) string {
 	return fmt.Sprintf("JSON attribute: %s.%s", fieldSetKey, fieldKey)
 }
@@ -86,60 +171,131 @@ func (l *JSONFileLoader) findValueInMaps(fieldSetKey, fieldKey string, maps *[]m
 		return "", false
 	}
 
-	for _, fileMap := range *maps {
-		fieldSetAny, found := fileMap[fieldSetKey]
-		if !found {
-			continue
-		}
+	value, found := findRawValueInMaps(fieldSetKey, fieldKey, *maps)
+	if !found {
+		return "", false
+	}
 
-		fieldSetMap, ok := fieldSetAny.(map[string]any)
+	if stringValue, isString := value.(string); isString {
+		return stringValue, true
+	}
+
+	// arrays are kept as JSON array values, which list fields parse without splitting elements on delimiters
+	valueBytes, _ := json.Marshal(value)
+
+	return string(valueBytes), true
+}
+
+// findRawValueInMaps returns the first decoded value found for the field in the file maps, in priority order, where
+// null values are treated as not found.
+func findRawValueInMaps(fieldSetKey, fieldKey string, maps []map[string]any) (any, bool) {
+	for _, fileMap := range maps {
+		fieldSetMap, ok := fileMap[fieldSetKey].(map[string]any)
 		if !ok {
 			continue
 		}
 
-		value, ok := fieldSetMap[fieldKey]
-		if !ok {
-			continue
+		if value, found := fieldSetMap[fieldKey]; found && value != nil {
+			return value, true
 		}
+	}
 
-		bytes, _ := json.Marshal(value)
-		valueString := string(bytes)
+	return nil, false
+}
 
-		if strings.HasPrefix(valueString, "[") && strings.HasSuffix(valueString, "]") {
-			valueString = valueString[1 : len(valueString)-1]
-			valueStringSlice := strings.Split(valueString, ",")
+// Errors returns errors for required files that cannot be read, and for files that cannot be decoded. Decode errors
+// from the default decoder include the line and column of the problem.
+func (l *JSONFileLoader) Errors() []error {
+	if l.prepared != nil {
+		return l.prepared.errs
+	}
 
-			for index, val := range valueStringSlice {
-				valueStringSlice[index] = strings.Trim(val, "\"")
-			}
+	_, errs := l.readFileMaps()
 
-			valueString = strings.Join(valueStringSlice, ",")
-		} else {
-			valueString = strings.Trim(valueString, "\"")
-		}
+	return errs
+}
 
-		return valueString, true
+func (l *JSONFileLoader) fileMaps() []map[string]any {
+	if l.prepared != nil {
+		return l.prepared.fileMaps
 	}
 
-	return "", false
+	fileMaps, _ := l.readFileMaps()
+
+	return fileMaps
 }
 
-func (l *JSONFileLoader) fileMaps() []map[string]any {
+func (l *JSONFileLoader) readFileMaps() ([]map[string]any, []error) {
 	fileMaps := []map[string]any{}
+	errs := []error{}
 
-	for _, path := range l.FilePaths {
+	for _, path := range l.profileFilePaths() {
 		fileBytes, err := os.ReadFile(path)
-		if err != nil {
+		if errors.Is(err, fs.ErrNotExist) && l.optionalFilePath(path) {
+			continue
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("problem reading file '%s': %w", path, err))
 			continue
 		}
 
 		fileMap := map[string]any{}
 		if err := l.Decoder(fileBytes, &fileMap); err != nil {
+			errs = append(errs, jsonDecodeError(path, fileBytes, err))
 			continue
 		}
 
 		fileMaps = append(fileMaps, fileMap)
 	}
 
-	return fileMaps
+	return fileMaps, errs
+}
+
+// optionalFilePath checks whether a file path may be missing, which applies to optional file paths and to
+// profile-specific file paths.
+func (l *JSONFileLoader) optionalFilePath(path string) bool {
+	return slices.Contains(l.OptionalFilePaths, path) || !slices.Contains(l.FilePaths, path)
+}
+
+// jsonDecodeError describes a file decoding error, including the line and column for JSON syntax and type errors.
+func jsonDecodeError(path string, fileBytes []byte, err error) error {
+	var (
+		offset      int64
+		syntaxError *json.SyntaxError
+		typeError   *json.UnmarshalTypeError
+	)
+
+	switch {
+	case errors.As(err, &syntaxError):
+		offset = syntaxError.Offset
+	case errors.As(err, &typeError):
+		offset = typeError.Offset
+	default:
+		return fmt.Errorf("problem decoding file '%s': %w", path, err)
+	}
+
+	// the offset follows the byte where the problem was found
+	errorIndex := min(max(offset-1, 0), int64(len(fileBytes)))
+	line := bytes.Count(fileBytes[:errorIndex], []byte("\n")) + 1
+	column := int(errorIndex) - bytes.LastIndexByte(fileBytes[:errorIndex], '\n')
+
+	return fmt.Errorf("problem decoding file '%s' at line %d, column %d: %w", path, line, column, err)
+}
+
+// profileFilePaths returns the file paths to read in priority order, with profile-specific file paths preceding their
+// base file path when a profile is set.
+func (l *JSONFileLoader) profileFilePaths() []string {
+	if l.Profile == "" {
+		return l.FilePaths
+	}
+
+	paths := make([]string, 0, len(l.FilePaths)*2)
+
+	for _, path := range l.FilePaths {
+		extension := filepath.Ext(path)
+		profilePath := fmt.Sprintf("%s.%s%s", strings.TrimSuffix(path, extension), l.Profile, extension)
+
+		paths = append(paths, profilePath, path)
+	}
+
+	return paths
 }
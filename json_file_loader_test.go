@@ -3,6 +3,8 @@ package bconf_test
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -83,8 +85,13 @@ func TestJSONFileLoaderGet(t *testing.T) {
 		t.Fatalf("expected loader with fixture file to find internalPorts value")
 	}
 
-	if internalPorts != "8081,8082" {
-		t.Fatalf("unexpected appPort value '%s', expected '8080'", internalPorts)
+	if internalPorts != "[8081,8082]" {
+		t.Fatalf("unexpected internalPorts value '%s', expected '[8081,8082]'", internalPorts)
+	}
+
+	someKey, _ := loaderFixture01.Get("app", "some_key")
+	if someKey != `["what if","a list","of strings"]` {
+		t.Fatalf("unexpected someKey value '%s', expected JSON array value", someKey)
 	}
 
 	_, found = loaderNoFilePaths.Get("app", "id")
@@ -128,6 +135,136 @@ func TestJSONFileLoaderHelpString(t *testing.T) {
 	}
 }
 
+func TestJSONFileLoaderProfile(t *testing.T) {
+	loader := bconf.NewJSONFileLoaderWithAttributes(json.Unmarshal, "./fixtures/json_config_test_fixture_03.json")
+
+	host, found := loader.Get("api", "host")
+	if !found || host != "localhost" {
+		t.Fatalf("unexpected api host value '%s' (found: %t), expected 'localhost'", host, found)
+	}
+
+	loader.SetProfile("prod")
+
+	host, found = loader.Get("api", "host")
+	if !found || host != "api.example.com" {
+		t.Fatalf("unexpected api host value '%s' (found: %t), expected 'api.example.com'", host, found)
+	}
+
+	port, found := loader.Get("api", "port")
+	if !found || port != "8080" {
+		t.Fatalf("unexpected api port value '%s' (found: %t), expected base file value '8080'", port, found)
+	}
+
+	loader.SetProfile("staging")
+
+	host, found = loader.Get("api", "host")
+	if !found || host != "localhost" {
+		t.Fatalf("unexpected api host value '%s' (found: %t), expected 'localhost'", host, found)
+	}
+}
+
+func TestJSONFileLoaderErrors(t *testing.T) {
+	tempDir := t.TempDir()
+	malformedPath := filepath.Join(tempDir, "malformed.json")
+	mistypedPath := filepath.Join(tempDir, "mistyped.json")
+	missingPath := filepath.Join(tempDir, "missing.json")
+
+	malformed := "{\n  \"api\": {\n    \"host\": \"localhost\",,\n  }\n}\n"
+
+	if err := os.WriteFile(malformedPath, []byte(malformed), 0o600); err != nil {
+		t.Fatalf("unexpected error writing malformed file: %s", err)
+	}
+
+	if err := os.WriteFile(mistypedPath, []byte("[\n  1\n]\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error writing mistyped file: %s", err)
+	}
+
+	if errs := loaderWithTestFixture01().Errors(); len(errs) > 0 {
+		t.Errorf("unexpected errors from loader with valid file: %v", errs)
+	}
+
+	loader := bconf.NewJSONFileLoaderWithAttributes(nil, missingPath, malformedPath, mistypedPath)
+
+	errs := loader.Errors()
+	if len(errs) != 3 {
+		t.Fatalf("unexpected loader errors length '%d', expected '3': %v", len(errs), errs)
+	}
+
+	expectedErrors := []string{
+		fmt.Sprintf("problem reading file '%s'", missingPath),
+		fmt.Sprintf("problem decoding file '%s' at line 3, column 25", malformedPath),
+		fmt.Sprintf("problem decoding file '%s' at line 1, column 1", mistypedPath),
+	}
+
+	for idx, expected := range expectedErrors {
+		if !strings.HasPrefix(errs[idx].Error(), expected) {
+			t.Errorf("unexpected loader error '%s', expected prefix '%s'", errs[idx], expected)
+		}
+	}
+
+	loader = bconf.NewJSONFileLoaderWithAttributes(nil, "./fixtures/json_config_test_fixture_03.json", missingPath)
+	loader.OptionalFilePaths = []string{missingPath}
+	loader.Profile = "staging"
+
+	if errs := loader.Errors(); len(errs) > 0 {
+		t.Errorf("unexpected errors for missing optional and profile-specific files: %v", errs)
+	}
+
+	if errs := loaderWithBadDecoder().Errors(); len(errs) != 1 || !strings.Contains(errs[0].Error(), "decoder error") {
+		t.Errorf("expected decoder error, found: %v", errs)
+	}
+}
+
+func TestAppConfigJSONFileLoaderErrors(t *testing.T) {
+	missingPath := filepath.Join(t.TempDir(), "missing.json")
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_03.json", missingPath),
+	)
+
+	errs := appConfig.Load()
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), missingPath) {
+		t.Fatalf("expected missing file error loading app config, found: %v", errs)
+	}
+
+	option := bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_03.json")
+	option.WithOptionalFiles(missingPath)
+
+	appConfig = bconf.NewAppConfig("testapp", "testapp description", option)
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config with missing optional file: %v", errs)
+	}
+
+	overridePath := filepath.Join(t.TempDir(), "local.json")
+	if err := os.WriteFile(overridePath, []byte(`{"api": {"port": 9090}}`), 0o600); err != nil {
+		t.Fatalf("unexpected error writing override file: %s", err)
+	}
+
+	for _, filePath := range []string{overridePath, missingPath} {
+		option = bconf.WithJSONFileLoader(filePath, "./fixtures/json_config_test_fixture_03.json")
+		option.WithOptionalFiles(filePath)
+
+		appConfig = bconf.NewAppConfig("testapp", "testapp description", option)
+		appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("port", bconf.Int).C()).C())
+
+		if errs := appConfig.Load(); len(errs) > 0 {
+			t.Fatalf("unexpected error(s) loading app config with optional file '%s': %v", filePath, errs)
+		}
+
+		expectedPort := 8080
+		if filePath == overridePath {
+			expectedPort = 9090
+		}
+
+		if port, _ := appConfig.GetInt("api", "port"); port != expectedPort {
+			t.Errorf("unexpected port '%d' with optional file '%s', expected '%d'", port, filePath, expectedPort)
+		}
+	}
+}
+
 func loaderWithTestFixture01() *bconf.JSONFileLoader {
 	return bconf.NewJSONFileLoaderWithAttributes(json.Unmarshal, "./fixtures/json_config_test_fixture_01.json")
 }
@@ -0,0 +1,172 @@
+package bconf
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+	// jsonSchemaDurationPattern matches values accepted by time.ParseDuration
+	jsonSchemaDurationPattern = `^[-+]?(0|((\d+(\.\d*)?|\.\d+)(ns|us|µs|μs|ms|s|m|h))+)$`
+)
+
+// JSONSchema returns a JSON Schema (draft 2020-12) describing the files consumed by the JSONFileLoader. Every
+// field-set is described as an object, and every field with its type, description, default value, accepted values,
+// and format (RFC 3339 date-times and duration strings). Required fields are listed as required unless the field or
+// its field-set is conditionally loaded. Default values of sensitive fields are omitted, and custom Validator
+// functions cannot be represented in the schema. Fields that deny the JSON file loader are excluded.
+func (c *AppConfig) JSONSchema() ([]byte, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	properties := map[string]any{}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		fieldSetProperties := map[string]any{}
+		required := []string{}
+
+		for _, fieldKey := range slices.Sorted(slices.Values(fieldSet.fieldKeys())) {
+			field := fieldSet.fieldMap[fieldKey]
+
+			if !field.loaderAllowed(LoaderNameJSONFile) {
+				continue
+			}
+
+			fieldSetProperties[field.Key] = jsonSchemaFieldProperty(field)
+
+			if field.Required && len(field.LoadConditions) < 1 && len(fieldSet.LoadConditions) < 1 {
+				required = append(required, field.Key)
+			}
+		}
+
+		fieldSetSchema := map[string]any{
+			"type":                 "object",
+			"properties":           fieldSetProperties,
+			"additionalProperties": false,
+		}
+
+		if len(required) > 0 {
+			fieldSetSchema["required"] = required
+		}
+
+		if command := c.fieldSetCommands[fieldSet.Key]; command != "" {
+			fieldSetSchema["description"] = fmt.Sprintf("Loaded for command '%s'", command)
+		}
+
+		properties[fieldSet.Key] = fieldSetSchema
+	}
+
+	schema := map[string]any{
+		"$schema":              jsonSchemaDraft,
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+
+	if name := c.appValue("name"); name != "" {
+		schema["title"] = name
+	}
+
+	if description := c.appValue("description"); description != "" {
+		schema["description"] = description
+	}
+
+	schemaBytes, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("problem encoding json schema: %w", err)
+	}
+
+	return schemaBytes, nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func jsonSchemaFieldProperty(field *Field) map[string]any {
+	property := map[string]any{}
+
+	if isListFieldType(field.Type) {
+		property["type"] = "array"
+		property["items"] = jsonSchemaType(field.Type[2:])
+	} else {
+		for key, value := range jsonSchemaType(field.Type) {
+			property[key] = value
+		}
+	}
+
+	if field.Description != "" {
+		property["description"] = field.Description
+	}
+
+	if field.Default != nil && !field.Sensitive {
+		property["default"] = jsonSchemaValue(field.Default)
+	}
+
+	if field.Sensitive {
+		property["writeOnly"] = true
+	}
+
+	// JSON Schema enum matching is case-sensitive, so case-insensitive enumerations are not included
+	if len(field.Enumeration) > 0 && !field.EnumerationCaseInsensitive {
+		enumeration := make([]any, len(field.Enumeration))
+
+		for idx, value := range field.Enumeration {
+			enumeration[idx] = jsonSchemaValue(value)
+		}
+
+		if items, ok := property["items"].(map[string]any); ok {
+			items["enum"] = enumeration
+		} else {
+			property["enum"] = enumeration
+		}
+	}
+
+	return property
+}
+
+func jsonSchemaType(fieldType string) map[string]any {
+	switch fieldType {
+	case Bool:
+		return map[string]any{"type": "boolean"}
+	case Int:
+		return map[string]any{"type": "integer"}
+	case Float:
+		return map[string]any{"type": "number"}
+	case Time:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case Duration:
+		return map[string]any{"type": "string", "pattern": jsonSchemaDurationPattern}
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
+
+// jsonSchemaValue converts a field value to the representation read by the JSONFileLoader.
+func jsonSchemaValue(value any) any {
+	switch typedValue := value.(type) {
+	case time.Time:
+		return typedValue.Format(time.RFC3339)
+	case time.Duration:
+		return typedValue.String()
+	case []time.Time:
+		values := make([]string, len(typedValue))
+
+		for idx, elem := range typedValue {
+			values[idx] = elem.Format(time.RFC3339)
+		}
+
+		return values
+	case []time.Duration:
+		values := make([]string, len(typedValue))
+
+		for idx, elem := range typedValue {
+			values[idx] = elem.String()
+		}
+
+		return values
+	default:
+		return value
+	}
+}
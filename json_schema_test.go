@@ -0,0 +1,82 @@
+package bconf_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigJSONSchema(t *testing.T) {
+	appConfig := bconf.NewAppConfig("testapp", "testapp description", bconf.WithEnvironmentLoader(""))
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).Required().Description("api host").C(),
+		bconf.FB("port", bconf.Int).Default(8080).C(),
+		bconf.FB("timeout", bconf.Duration).Default(5*time.Second).C(),
+		bconf.FB("methods", bconf.Strings).Default([]string{"GET"}).Enumeration("GET", "POST").C(),
+		bconf.FB("scheme", bconf.String).Default("https").Enumeration("http", "https").C(),
+		bconf.FB("token", bconf.String).Default("secret").Sensitive().C(),
+		bconf.FB("internal", bconf.String).Default("value").DeniedLoaders(bconf.LoaderNameJSONFile).C(),
+	).C())
+
+	t.Setenv("API_HOST", "localhost")
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	schemaBytes, err := appConfig.JSONSchema()
+	if err != nil {
+		t.Fatalf("unexpected error generating json schema: %s", err)
+	}
+
+	schema := map[string]any{}
+	if err := json.Unmarshal(schemaBytes, &schema); err != nil {
+		t.Fatalf("unexpected error decoding json schema: %s", err)
+	}
+
+	if schema["title"] != "testapp" || schema["$schema"] != "https://json-schema.org/draft/2020-12/schema" {
+		t.Errorf("unexpected json schema metadata: %v", schema)
+	}
+
+	api, _ := schema["properties"].(map[string]any)["api"].(map[string]any)
+	if api == nil {
+		t.Fatalf("expected 'api' field-set in json schema, found: %s", schemaBytes)
+	}
+
+	if !reflect.DeepEqual(api["required"], []any{"host"}) {
+		t.Errorf("unexpected required fields: %v", api["required"])
+	}
+
+	properties := api["properties"].(map[string]any)
+
+	expectedProperties := map[string]map[string]any{
+		"host":    {"type": "string", "description": "api host"},
+		"port":    {"type": "integer", "default": float64(8080)},
+		"timeout": {"type": "string", "default": "5s"},
+		"methods": {
+			"type":    "array",
+			"default": []any{"GET"},
+			"items":   map[string]any{"type": "string", "enum": []any{"GET", "POST"}},
+		},
+		"scheme": {"type": "string", "enum": []any{"http", "https"}},
+		"token":  {"type": "string", "writeOnly": true},
+	}
+
+	for fieldKey, expected := range expectedProperties {
+		property, _ := properties[fieldKey].(map[string]any)
+
+		for key, value := range expected {
+			if !reflect.DeepEqual(property[key], value) {
+				t.Errorf("unexpected '%s' property '%s' value: expected '%v', found '%v'", fieldKey, key, value, property[key])
+			}
+		}
+	}
+
+	if _, found := properties["internal"]; found {
+		t.Errorf("unexpected field denying the json file loader in json schema")
+	}
+}
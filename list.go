@@ -0,0 +1,188 @@
+package bconf
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DefaultListDelimiter is the delimiter separating list field elements when a field does not define a ListDelimiter.
+const DefaultListDelimiter = ","
+
+// listElements splits a list field value into its element strings. Values formatted as a JSON array (e.g.
+// '["a,b", "c"]') are decoded as JSON, and other values are split on the field list delimiter, where elements may be
+// wrapped in double quotes to include the delimiter, with '""' escaping a double quote (e.g. '"a,b", c').
+func (f *Field) listElements(value string) ([]string, error) {
+	if elements, ok := jsonListElements(value); ok {
+		return elements, nil
+	}
+
+	return splitListValue(value, f.listDelimiter())
+}
+
+func (f *Field) listDelimiter() string {
+	if f.ListDelimiter == "" {
+		return DefaultListDelimiter
+	}
+
+	return f.ListDelimiter
+}
+
+// listFieldDelimiter returns the list delimiter of a loader field.
+func listFieldDelimiter(field LoaderField) string {
+	if field.ListDelimiter == "" {
+		return DefaultListDelimiter
+	}
+
+	return field.ListDelimiter
+}
+
+func (f *Field) validateListDelimiter() error {
+	switch {
+	case f.ListDelimiter == "":
+		return nil
+	case !isListFieldType(f.Type):
+		return fmt.Errorf("invalid list delimiter: field-type '%s' is not a list field-type", f.Type)
+	case strings.Contains(f.ListDelimiter, `"`) || strings.TrimSpace(f.ListDelimiter) == "":
+		return fmt.Errorf(
+			"invalid list delimiter '%s': cannot contain double quotes or only white space", f.ListDelimiter,
+		)
+	default:
+		return nil
+	}
+}
+
+// parseListValue parses each list element of a value with the provided element parsing function.
+func parseListValue[T any](f *Field, value string, parseElement func(string) (T, error)) ([]T, error) {
+	elements, err := f.listElements(value)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]T, len(elements))
+
+	for idx, element := range elements {
+		element, err := f.transformRawString(element)
+		if err != nil {
+			return nil, err
+		}
+
+		parsedValue, err := parseElement(element)
+		if err != nil {
+			return nil, err
+		}
+
+		values[idx] = parsedValue
+	}
+
+	return values, nil
+}
+
+// jsonListElements decodes a JSON array value into element strings, where string elements are unquoted and other
+// elements keep their JSON representation.
+func jsonListElements(value string) ([]string, bool) {
+	value = strings.TrimSpace(value)
+
+	if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+		return nil, false
+	}
+
+	rawElements := []json.RawMessage{}
+	if err := json.Unmarshal([]byte(value), &rawElements); err != nil {
+		return nil, false
+	}
+
+	elements := make([]string, len(rawElements))
+
+	for idx, rawElement := range rawElements {
+		if err := json.Unmarshal(rawElement, &elements[idx]); err != nil {
+			elements[idx] = string(rawElement)
+		}
+	}
+
+	return elements, true
+}
+
+// splitListValue splits a value on the delimiter, trimming spaces around elements. Elements starting with a double
+// quote are read up to the closing double quote, keeping delimiters and spaces within the quotes.
+func splitListValue(value, delimiter string) ([]string, error) {
+	elements := []string{}
+	rest := value
+
+	for {
+		unquoted := strings.TrimLeft(rest, " ")
+
+		if !strings.HasPrefix(unquoted, `"`) {
+			idx := strings.Index(rest, delimiter)
+			if idx < 0 {
+				return append(elements, strings.Trim(rest, " ")), nil
+			}
+
+			elements = append(elements, strings.Trim(rest[:idx], " "))
+			rest = rest[idx+len(delimiter):]
+
+			continue
+		}
+
+		element, remaining, err := readQuotedListElement(unquoted)
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, element)
+		remaining = strings.TrimLeft(remaining, " ")
+
+		switch {
+		case remaining == "":
+			return elements, nil
+		case strings.HasPrefix(remaining, delimiter):
+			rest = remaining[len(delimiter):]
+		default:
+			return nil, fmt.Errorf(
+				"problem parsing list value: unexpected characters after quoted element '%s'", element,
+			)
+		}
+	}
+}
+
+// readQuotedListElement reads a double-quoted element from the start of the value, returning the unquoted element and
+// the remaining value.
+func readQuotedListElement(value string) (string, string, error) {
+	builder := strings.Builder{}
+
+	for idx := 1; idx < len(value); idx++ {
+		if value[idx] != '"' {
+			builder.WriteByte(value[idx])
+			continue
+		}
+
+		if idx+1 < len(value) && value[idx+1] == '"' {
+			builder.WriteByte('"')
+			idx++
+
+			continue
+		}
+
+		return builder.String(), value[idx+1:], nil
+	}
+
+	return "", "", fmt.Errorf("problem parsing list value: unterminated quoted element")
+}
+
+// joinListValues joins list elements with the delimiter, quoting elements that would not be split back into the same
+// elements.
+func joinListValues(elements []string, delimiter string) string {
+	quotedElements := make([]string, len(elements))
+
+	for idx, element := range elements {
+		// the first element is quoted when it could be read as the start of a JSON array
+		if strings.Contains(element, delimiter) || strings.HasPrefix(element, `"`) ||
+			strings.TrimSpace(element) != element || idx == 0 && strings.HasPrefix(element, "[") {
+			element = fmt.Sprintf(`"%s"`, strings.ReplaceAll(element, `"`, `""`))
+		}
+
+		quotedElements[idx] = element
+	}
+
+	return strings.Join(quotedElements, delimiter)
+}
@@ -0,0 +1,106 @@
+package bconf_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigListParsing(t *testing.T) {
+	t.Setenv("BCONF_LIST_TEST_API_QUOTED", `"a,b", c , "say ""hi"""`)
+	t.Setenv("BCONF_LIST_TEST_API_DELIMITED", "a,b; c")
+	t.Setenv("BCONF_LIST_TEST_API_JSON", `["a,b", "c"]`)
+	t.Setenv("BCONF_LIST_TEST_API_TIMEOUTS", `["1s", "2m"]`)
+	t.Setenv("BCONF_LIST_TEST_API_PORTS", "8080;8081")
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_list_test"),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("quoted", bconf.Strings).C(),
+		bconf.FB("delimited", bconf.Strings).ListDelimiter(";").C(),
+		bconf.FB("json", bconf.Strings).C(),
+		bconf.FB("timeouts", bconf.Durations).C(),
+		bconf.FB("ports", bconf.Ints).ListDelimiter(";").C(),
+		bconf.FB("hosts", bconf.Strings).C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	expectedStrings := map[string][]string{
+		"quoted":    {"a,b", "c", `say "hi"`},
+		"delimited": {"a,b", "c"},
+		"json":      {"a,b", "c"},
+	}
+
+	for fieldKey, expected := range expectedStrings {
+		if values, _ := appConfig.GetStrings("api", fieldKey); !slices.Equal(values, expected) {
+			t.Errorf("unexpected '%s' values %q, expected %q", fieldKey, values, expected)
+		}
+	}
+
+	if timeouts, _ := appConfig.GetDurations("api", "timeouts"); !slices.Equal(
+		timeouts, []time.Duration{time.Second, 2 * time.Minute},
+	) {
+		t.Errorf("unexpected timeouts values %v", timeouts)
+	}
+
+	if ports, _ := appConfig.GetInts("api", "ports"); !slices.Equal(ports, []int{8080, 8081}) {
+		t.Errorf("unexpected ports values %v", ports)
+	}
+
+	if err := appConfig.SetFieldString("api", "hosts", `"unterminated, value`); err == nil {
+		t.Error("expected error setting list value with an unterminated quoted element")
+	}
+
+	if err := appConfig.SetFieldString("api", "hosts", `"a" b, c`); err == nil {
+		t.Error("expected error setting list value with characters after a quoted element")
+	}
+
+	if err := appConfig.SetFieldString("api", "hosts", `["[x]", "a,b", " c"]`); err != nil {
+		t.Fatalf("unexpected error setting list value: %s", err)
+	}
+
+	exported, err := appConfig.Export(bconf.ExportOptions{Format: bconf.ExportFormatFlat})
+	if err != nil {
+		t.Fatalf("unexpected error exporting config: %s", err)
+	}
+
+	for _, expected := range []string{
+		`api.hosts="[x]","a,b"," c"` + "\n",
+		"api.delimited=a,b;c\n",
+	} {
+		if !strings.Contains(string(exported), expected) {
+			t.Errorf("expected exported config to contain '%s', found:\n%s", expected, exported)
+		}
+	}
+
+	_, exportedHosts, _ := strings.Cut(string(exported), "api.hosts=")
+	exportedHosts, _, _ = strings.Cut(exportedHosts, "\n")
+
+	if err := appConfig.SetFieldString("api", "hosts", exportedHosts); err != nil {
+		t.Fatalf("unexpected error setting exported list value: %s", err)
+	}
+
+	if hosts, _ := appConfig.GetStrings("api", "hosts"); !slices.Equal(hosts, []string{"[x]", "a,b", " c"}) {
+		t.Errorf("unexpected hosts values %q after round-trip", hosts)
+	}
+
+	invalidConfig := createBaseAppConfig()
+
+	invalidConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).ListDelimiter(";").C(),
+	).C())
+
+	if errs := invalidConfig.Load(); len(errs) < 1 {
+		t.Fatal("expected error loading app config with a list delimiter on a non-list field")
+	}
+}
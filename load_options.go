@@ -1,8 +1,10 @@
 package bconf
 
 const (
-	loadOptionTypeDisableHelpFlag     = "disable_help_flag_handler"
-	loadOptionTypeDisableGenerateFlag = "disable_generate_flag_handler"
+	loadOptionTypeDisableHelpFlag        = "disable_help_flag_handler"
+	loadOptionTypeDisableGenerateFlag    = "disable_generate_flag_handler"
+	loadOptionTypeDisableCheckConfigFlag = "disable_check_config_flag_handler"
+	loadOptionTypeStrictMode             = "strict_mode"
 )
 
 type LoadOption interface {
@@ -17,6 +19,17 @@ func DisableGenerateFlagHandler() LoadOption {
 	return loadOptionDisableGenerateFlag{}
 }
 
+// DisableCheckConfigFlagHandler disables handling of the reserved '--check-config' flag.
+func DisableCheckConfigFlagHandler() LoadOption {
+	return loadOptionDisableCheckConfigFlag{}
+}
+
+// EnableStrictMode reports keys found by loaders implementing the StrictLoader interface that do not match a registered
+// field (e.g. a misspelled flag, environment variable, or JSON attribute) as load errors.
+func EnableStrictMode() LoadOption {
+	return loadOptionStrictMode{}
+}
+
 type loadOptionDisableHelpFlag struct{}
 
 func (o loadOptionDisableHelpFlag) LoadOptionType() string {
@@ -28,3 +41,15 @@ type loadOptionDisableGenerateFlag struct{}
 func (o loadOptionDisableGenerateFlag) LoadOptionType() string {
 	return loadOptionTypeDisableGenerateFlag
 }
+
+type loadOptionDisableCheckConfigFlag struct{}
+
+func (o loadOptionDisableCheckConfigFlag) LoadOptionType() string {
+	return loadOptionTypeDisableCheckConfigFlag
+}
+
+type loadOptionStrictMode struct{}
+
+func (o loadOptionStrictMode) LoadOptionType() string {
+	return loadOptionTypeStrictMode
+}
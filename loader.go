@@ -1,5 +1,13 @@
 package bconf
 
+// Loader names returned by the bconf loader implementations, for use with loader precedence and field loader
+// restrictions.
+const (
+	LoaderNameEnvironment = "bconf_environment"
+	LoaderNameFlag        = "bconf_flags"
+	LoaderNameJSONFile    = "bconf_jsonfile"
+)
+
 type Loader interface {
 	CloneLoader() Loader
 	Name() string
@@ -8,6 +16,77 @@ type Loader interface {
 	HelpString(fieldSetKey, fieldKey string) string
 }
 
+// ProfileLoader is an optional Loader extension for loaders with profile-specific sources. The AppConfig sets the
+// active profile on these loaders once the 'app' field-set has been loaded.
+type ProfileLoader interface {
+	Loader
+	SetProfile(profile string)
+}
+
+// LoaderField describes a registered field to loaders that need field metadata to parse their source.
+type LoaderField struct {
+	FieldSetKey   string
+	FieldKey      string
+	FieldType     string
+	FlagShorthand string
+	// ListDelimiter is the delimiter separating list field elements
+	ListDelimiter string
+	// Command is the name of the command the field belongs to, or empty for fields shared by all commands
+	Command string
+}
+
+// FieldAwareLoader is an optional Loader extension for loaders that parse their source using field metadata. The
+// AppConfig registers all field-set fields with these loaders before loading values.
+type FieldAwareLoader interface {
+	Loader
+	SetFields(fields []LoaderField)
+}
+
+// CommandLoader is an optional Loader extension for loaders that scope their source to the selected command. The
+// AppConfig sets the selected command on these loaders before loading values.
+type CommandLoader interface {
+	Loader
+	SetCommand(command string)
+}
+
+// TypedLoader is an optional Loader extension for loaders whose source holds typed values (e.g. decoded JSON numbers,
+// bools, and arrays). Values are converted to the field-type without a string round-trip, where float64 numbers with
+// integral values convert to int, arrays convert element-wise to list field-types, scalar values convert to single
+// element lists, and string values are parsed as loader string values (e.g. RFC3339 times and durations). Nil values
+// (e.g. JSON null) are treated as not found, and object values and nested arrays of list values load from their JSON
+// text.
+type TypedLoader interface {
+	Loader
+	GetTypedMap(fieldSetKey string, fieldKeys []string) map[string]any
+}
+
+// LifecycleLoader is an optional Loader extension for loaders that read their source once per load. The AppConfig
+// calls Prepare before looking up field values, where the loader reads, parses, and caches its source, and Finish
+// after loading, where the loader releases its cached source. Lookups outside of a prepared load read the source
+// directly.
+type LifecycleLoader interface {
+	Loader
+	Prepare()
+	Finish()
+}
+
+// ErrorReportingLoader is an optional Loader extension for loaders that can report problems with their source. Errors
+// are returned from AppConfig Load.
+type ErrorReportingLoader interface {
+	Loader
+	Errors() []error
+}
+
+// StrictLoader is an optional Loader extension used by strict mode to find configuration keys in a loader source that
+// do not match a registered field.
+type StrictLoader interface {
+	Loader
+	// Key returns the loader source key for a field (e.g. an environment variable name).
+	Key(fieldSetKey, fieldKey string) string
+	// SeenKeys returns the keys found in the loader source, in the same format returned by Key.
+	SeenKeys() []string
+}
+
 type LoaderKeyOverride struct {
 	LoaderName     string
 	KeyOverride    string
@@ -0,0 +1,182 @@
+package bconf_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigLoaderLifecycle(t *testing.T) {
+	decodes := 0
+	decoder := func(data []byte, v any) error {
+		decodes++
+		return json.Unmarshal(data, v)
+	}
+
+	option := bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_01.json")
+	option.WithDecoder(decoder)
+
+	appConfig := bconf.NewAppConfig("testapp", "testapp description", option)
+
+	for idx := range 20 {
+		appConfig.AddFieldSet(bconf.FSB(fmt.Sprintf("field_set_%d", idx)).Fields(
+			bconf.FB("field", bconf.String).Default("value").C(),
+		).C())
+	}
+
+	appConfig.AddFieldSet(bconf.FSB("log").Fields(bconf.FB("level", bconf.String).C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if decodes != 1 {
+		t.Errorf("unexpected json file decodes during load '%d', expected '1'", decodes)
+	}
+
+	if level, _ := appConfig.GetString("log", "level"); level != "info" {
+		t.Errorf("unexpected log level '%s', expected 'info'", level)
+	}
+
+	if _, errs := appConfig.ReloadFieldSet("log"); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) reloading field-set: %v", errs)
+	}
+
+	if decodes != 2 {
+		t.Errorf("unexpected json file decodes after reload '%d', expected '2'", decodes)
+	}
+}
+
+func TestJSONFileLoaderPrepare(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+
+	if err := os.WriteFile(path, []byte(`{"api": {"host": "localhost"}}`), 0o600); err != nil {
+		t.Fatalf("unexpected error writing file: %s", err)
+	}
+
+	loader := bconf.NewJSONFileLoaderWithAttributes(nil, path)
+	loader.Prepare()
+
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("unexpected error removing file: %s", err)
+	}
+
+	if host, found := loader.Get("api", "host"); !found || host != "localhost" {
+		t.Errorf("expected prepared loader to find cached host value, found '%s'", host)
+	}
+
+	if errs := loader.Errors(); len(errs) > 0 {
+		t.Errorf("unexpected errors from prepared loader: %v", errs)
+	}
+
+	loader.Finish()
+
+	if _, found := loader.Get("api", "host"); found {
+		t.Error("unexpected host value found after finishing loader with removed file")
+	}
+
+	if errs := loader.Errors(); len(errs) != 1 {
+		t.Errorf("expected missing file error after finishing loader, found: %v", errs)
+	}
+}
+
+func TestFlagLoaderPrepare(t *testing.T) {
+	loader := bconf.NewFlagLoader()
+	loader.OverrideLookup = []string{"--api_host", "localhost"}
+	loader.Prepare()
+
+	loader.OverrideLookup[1] = "example.com"
+
+	if host, _ := loader.Get("api", "host"); host != "localhost" {
+		t.Errorf("unexpected prepared host value '%s', expected 'localhost'", host)
+	}
+
+	loader.Finish()
+
+	if host, _ := loader.Get("api", "host"); host != "example.com" {
+		t.Errorf("unexpected host value '%s' after finishing loader, expected 'example.com'", host)
+	}
+}
+
+func BenchmarkAppConfigLoad(b *testing.B) {
+	for _, fieldSetCount := range []int{100, 500} {
+		b.Run(fmt.Sprintf("field_sets_%d", fieldSetCount), func(b *testing.B) {
+			benchmarkAppConfigLoad(b, fieldSetCount)
+		})
+	}
+}
+
+func benchmarkAppConfigLoad(b *testing.B, fieldSetCount int) {
+	const fieldCount = 5
+
+	fileMap := map[string]map[string]any{}
+	args := []string{"benchmark"}
+
+	for fieldSetIdx := range fieldSetCount {
+		fieldSetKey := fmt.Sprintf("field_set_%d", fieldSetIdx)
+		fileMap[fieldSetKey] = map[string]any{}
+
+		for fieldIdx := range fieldCount {
+			fileMap[fieldSetKey][fmt.Sprintf("field_%d", fieldIdx)] = fieldIdx
+		}
+
+		args = append(args, fmt.Sprintf("--%s_field_0=%d", fieldSetKey, fieldSetIdx))
+	}
+
+	fileBytes, err := json.Marshal(fileMap)
+	if err != nil {
+		b.Fatalf("unexpected error encoding benchmark file: %s", err)
+	}
+
+	path := filepath.Join(b.TempDir(), "config.json")
+
+	if err := os.WriteFile(path, fileBytes, 0o600); err != nil {
+		b.Fatalf("unexpected error writing benchmark file: %s", err)
+	}
+
+	osArgs := os.Args
+	os.Args = args
+
+	b.Cleanup(func() { os.Args = osArgs })
+
+	b.ResetTimer()
+
+	for range b.N {
+		appConfig := bconf.NewAppConfig(
+			"benchmark",
+			"benchmark description",
+			bconf.WithJSONFileLoader(path),
+			bconf.WithEnvironmentLoader("bconf_benchmark"),
+			bconf.WithFlagLoader(),
+		)
+
+		for fieldSetIdx := range fieldSetCount {
+			fields := make(bconf.Fields, fieldCount)
+
+			for fieldIdx := range fieldCount {
+				fields[fieldIdx] = bconf.FB(fmt.Sprintf("field_%d", fieldIdx), bconf.Int).C()
+			}
+
+			appConfig.AddFieldSet(bconf.FSB(fmt.Sprintf("field_set_%d", fieldSetIdx)).Fields(fields...).C())
+		}
+
+		if errs := appConfig.Load(); len(errs) > 0 {
+			b.Fatalf("unexpected error(s) loading app config: %s", strings.Join(errorStrings(errs), ", "))
+		}
+	}
+}
+
+func errorStrings(errs []error) []string {
+	messages := make([]string, len(errs))
+
+	for idx, err := range errs {
+		messages[idx] = err.Error()
+	}
+
+	return messages
+}
@@ -0,0 +1,185 @@
+package bconf
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+)
+
+// LogValue implements slog.LogValuer, logging field values grouped by field-set key with sensitive values masked.
+// Fields without a value are omitted.
+func (c *AppConfig) LogValue() slog.Value {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	attrs := []slog.Attr{}
+
+	for _, fieldSet := range c.orderedFieldSets {
+		fieldAttrs := []slog.Attr{}
+
+		for _, fieldKey := range slices.Sorted(slices.Values(fieldSet.fieldKeys())) {
+			field := fieldSet.fieldMap[fieldKey]
+
+			if _, err := field.getValue(); err != nil {
+				continue
+			}
+
+			fieldAttrs = append(fieldAttrs, slog.Attr{Key: field.Key, Value: field.logValue()})
+		}
+
+		if len(fieldAttrs) > 0 {
+			attrs = append(attrs, slog.Attr{Key: fieldSet.Key, Value: slog.GroupValue(fieldAttrs...)})
+		}
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements slog.LogValuer, logging the field key, type, value source, and value, where sensitive values
+// are masked. Field values of registered fields are read under the AppConfig read lock.
+func (f *Field) LogValue() slog.Value {
+	if f.lock != nil {
+		f.lock.RLock()
+		defer f.lock.RUnlock()
+	}
+
+	return slog.GroupValue(
+		slog.String("key", f.Key),
+		slog.String("type", f.Type),
+		slog.String("source", f.source()),
+		slog.Attr{Key: "value", Value: f.logValue()},
+	)
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func (f *Field) logValue() slog.Value {
+	value, err := f.getValue()
+
+	switch {
+	case err != nil:
+		return slog.AnyValue(nil)
+	case f.Sensitive:
+		return slog.StringValue(sensitiveValueMask)
+	default:
+		return slog.AnyValue(value)
+	}
+}
+
+// warn records a warning, logging it when a logger is configured.
+func (c *AppConfig) warn(message string, attrs ...slog.Attr) {
+	c.warnings = append(c.warnings, message)
+
+	if c.logger != nil {
+		c.logger.LogAttrs(context.Background(), slog.LevelWarn, message, attrs...)
+	}
+}
+
+// warnDeprecated records a warning for a deprecated field set by a loader. The warning is only recorded once, but is
+// logged each time the field is loaded.
+func (c *AppConfig) warnDeprecated(fieldSetKey string, field *Field, loaderName string) {
+	message := fmt.Sprintf(
+		"deprecated field '%s.%s' set by loader '%s': %s", fieldSetKey, field.Key, loaderName, field.Deprecated,
+	)
+
+	if !slices.Contains(c.warnings, message) {
+		c.warnings = append(c.warnings, message)
+	}
+
+	if c.logger != nil {
+		c.logger.LogAttrs(
+			context.Background(),
+			slog.LevelWarn,
+			"deprecated configuration field set",
+			slog.String("field_set", fieldSetKey),
+			slog.String("field", field.Key),
+			slog.String("loader", loaderName),
+			slog.String("deprecation", field.Deprecated),
+		)
+	}
+}
+
+func (c *AppConfig) logLoad(errs []error) {
+	if c.logger == nil {
+		return
+	}
+
+	if len(errs) > 0 {
+		c.logger.LogAttrs(
+			context.Background(),
+			slog.LevelError,
+			"configuration load failed",
+			slog.Any("errors", errorStrings(errs)),
+		)
+
+		return
+	}
+
+	c.logger.LogAttrs(
+		context.Background(),
+		slog.LevelInfo,
+		"configuration loaded",
+		slog.String("app", c.appValue("name")),
+		slog.String("profile", c.appValue("profile")),
+		slog.String("command", c.selectedCommand),
+		slog.Uint64("generation", c.generation),
+		slog.Int("field_sets", len(c.orderedFieldSets)),
+	)
+}
+
+func (c *AppConfig) logOverride(fieldSetKey, fieldKey string, previousValue, value slog.Value) {
+	if c.logger == nil {
+		return
+	}
+
+	c.logger.LogAttrs(
+		context.Background(),
+		slog.LevelInfo,
+		"configuration field overridden",
+		slog.String("field_set", fieldSetKey),
+		slog.String("field", fieldKey),
+		slog.Attr{Key: "value", Value: value},
+		slog.Attr{Key: "previous_value", Value: previousValue},
+	)
+}
+
+func (c *AppConfig) logReload(fieldSetKey, fieldKey string, changes FieldChanges, errs []error) {
+	if c.logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{slog.String("field_set", fieldSetKey)}
+
+	if fieldKey != "" {
+		attrs = append(attrs, slog.String("field", fieldKey))
+	}
+
+	if len(errs) > 0 {
+		attrs = append(attrs, slog.Any("errors", errorStrings(errs)))
+
+		c.logger.LogAttrs(context.Background(), slog.LevelError, "configuration reload failed", attrs...)
+
+		return
+	}
+
+	changedFields := make([]string, len(changes))
+
+	for idx, change := range changes {
+		changedFields[idx] = fmt.Sprintf("%s.%s", change.FieldSetKey, change.FieldKey)
+	}
+
+	attrs = append(attrs, slog.Any("changed_fields", changedFields))
+
+	c.logger.LogAttrs(context.Background(), slog.LevelInfo, "configuration reloaded", attrs...)
+}
+
+func errorStrings(errs []error) []string {
+	messages := make([]string, len(errs))
+
+	for idx, err := range errs {
+		messages[idx] = err.Error()
+	}
+
+	return messages
+}
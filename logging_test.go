@@ -0,0 +1,226 @@
+package bconf_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigLogValue(t *testing.T) {
+	t.Setenv("BCONF_LOG_VALUE_TEST_API_TOKEN", "secret-token")
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_log_value_test"),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).Default("localhost").C(),
+		bconf.FB("token", bconf.String).Sensitive().C(),
+		bconf.FB("unset", bconf.String).C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	buffer := &bytes.Buffer{}
+	slog.New(slog.NewJSONHandler(buffer, nil)).Info("config", "config", appConfig)
+
+	entry := struct {
+		Config map[string]map[string]any `json:"config"`
+	}{}
+
+	if err := json.Unmarshal(buffer.Bytes(), &entry); err != nil {
+		t.Fatalf("unexpected error decoding log entry: %s", err)
+	}
+
+	if entry.Config["api"]["host"] != "localhost" {
+		t.Errorf("unexpected logged host value: %v", entry.Config["api"]["host"])
+	}
+
+	if entry.Config["api"]["token"] != "<sensitive-value>" {
+		t.Errorf("expected logged token value to be masked, found: %v", entry.Config["api"]["token"])
+	}
+
+	if _, found := entry.Config["api"]["unset"]; found {
+		t.Errorf("expected unset field to be omitted from log value")
+	}
+
+	if entry.Config["app"]["name"] != "testapp" {
+		t.Errorf("unexpected logged app name value: %v", entry.Config["app"]["name"])
+	}
+
+	field, _ := appConfig.GetField("api", "token")
+
+	buffer.Reset()
+	slog.New(slog.NewTextHandler(buffer, nil)).Info("field", "field", field)
+
+	if !strings.Contains(buffer.String(), "field.key=token") ||
+		!strings.Contains(buffer.String(), "field.source=bconf_environment") ||
+		!strings.Contains(buffer.String(), "field.value=<sensitive-value>") ||
+		strings.Contains(buffer.String(), "secret-token") {
+		t.Errorf("unexpected field log output: %s", buffer.String())
+	}
+}
+
+func TestAppConfigWithLogger(t *testing.T) {
+	t.Setenv("BCONF_LOGGER_TEST_API_OLD_HOST", "example.com")
+
+	buffer := &bytes.Buffer{}
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_logger_test"),
+		bconf.WithLogger(slog.New(slog.NewTextHandler(buffer, nil))),
+		bconf.WithLoaderPrecedence("unknown_loader"),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("old_host", bconf.String).Deprecated("use 'api.host'").C(),
+		bconf.FB("token", bconf.String).Sensitive().C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if err := appConfig.SetField("api", "token", "secret-token"); err != nil {
+		t.Fatalf("unexpected error setting field: %s", err)
+	}
+
+	if _, errs := appConfig.ReloadFieldSet("api"); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) reloading field-set: %v", errs)
+	}
+
+	output := buffer.String()
+
+	for _, expected := range []string{
+		`level=WARN msg="loader precedence references unknown loader 'unknown_loader'"`,
+		`level=WARN msg="deprecated configuration field set" field_set=api field=old_host`,
+		`level=INFO msg="configuration loaded" app=testapp`,
+		`level=INFO msg="configuration field overridden" field_set=api field=token value=<sensitive-value>`,
+		`level=INFO msg="configuration reloaded" field_set=api`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected log output to contain '%s', found:\n%s", expected, output)
+		}
+	}
+
+	if strings.Contains(output, "secret-token") {
+		t.Errorf("expected sensitive value to be masked in log output:\n%s", output)
+	}
+
+	deprecatedWarnings := 0
+
+	for _, warning := range appConfig.Warnings() {
+		if strings.HasPrefix(warning, "deprecated field 'api.old_host'") {
+			deprecatedWarnings++
+		}
+	}
+
+	if deprecatedWarnings != 1 {
+		t.Errorf("expected a single deprecated field warning, found: %v", appConfig.Warnings())
+	}
+}
+
+func TestFieldLogValueConcurrentOverride(t *testing.T) {
+	appConfig := bconf.NewAppConfig("testapp", "testapp description")
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("port", bconf.Int).Default(8080).C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	field, err := appConfig.GetField("api", "port")
+	if err != nil {
+		t.Fatalf("unexpected error getting field: %s", err)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for port := range 100 {
+			_ = appConfig.SetField("api", "port", 9000+port)
+		}
+	}()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	for range 100 {
+		logger.Info("field", "field", field)
+	}
+
+	<-done
+
+	buffer := &bytes.Buffer{}
+	slog.New(slog.NewTextHandler(buffer, nil)).Info("field", "field", field)
+
+	if !strings.Contains(buffer.String(), "field.value=9099") {
+		t.Errorf("unexpected field log output: %s", buffer.String())
+	}
+}
+
+// configReadingHandler reads the AppConfig while handling log records.
+type configReadingHandler struct {
+	slog.Handler
+	appConfig **bconf.AppConfig
+}
+
+func (h configReadingHandler) Handle(ctx context.Context, record slog.Record) error {
+	if *h.appConfig != nil {
+		_, _ = (*h.appConfig).GetString("api", "host")
+		_ = (*h.appConfig).Warnings()
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func TestAppConfigLoggerReadsConfig(t *testing.T) {
+	var appConfig *bconf.AppConfig
+
+	buffer := &bytes.Buffer{}
+	handler := configReadingHandler{Handler: slog.NewTextHandler(buffer, nil), appConfig: &appConfig}
+
+	appConfig = bconf.NewAppConfig("testapp", "testapp description", bconf.WithLogger(slog.New(handler)))
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("host", bconf.String).Default("localhost").C()).C())
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		if errs := appConfig.Load(); len(errs) > 0 {
+			t.Errorf("unexpected error(s) loading app config: %v", errs)
+		}
+
+		if err := appConfig.SetField("api", "host", "api.example.com"); err != nil {
+			t.Errorf("unexpected error setting field: %s", err)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out logging with a handler reading the app config")
+	}
+
+	expected := `msg="configuration field overridden" field_set=api field=host value=api.example.com ` +
+		`previous_value=localhost`
+	if !strings.Contains(buffer.String(), expected) {
+		t.Errorf("expected log output to contain '%s', found:\n%s", expected, buffer.String())
+	}
+}
@@ -0,0 +1,90 @@
+package bconf
+
+import (
+	"fmt"
+	"slices"
+)
+
+// unknownKeyErrors returns an error for each key found by a StrictLoader that does not match a registered field,
+// suggesting the closest registered key when one is similar.
+func (c *AppConfig) unknownKeyErrors() []error {
+	errs := []error{}
+
+	for _, loader := range c.loaders {
+		strictLoader, ok := loader.(StrictLoader)
+		if !ok {
+			continue
+		}
+
+		knownKeys := []string{}
+
+		for _, fieldSet := range c.orderedFieldSets {
+			for _, fieldKey := range fieldSet.fieldKeys() {
+				knownKeys = append(knownKeys, strictLoader.Key(fieldSet.Key, fieldKey))
+			}
+		}
+
+		seenKeys := slices.Sorted(slices.Values(strictLoader.SeenKeys()))
+
+		for _, key := range slices.Compact(seenKeys) {
+			if slices.Contains(knownKeys, key) {
+				continue
+			}
+
+			if suggestion := closestKey(key, knownKeys); suggestion != "" {
+				errs = append(errs, fmt.Errorf(
+					"loader '%s' found unknown key '%s' (did you mean '%s'?)", loader.Name(), key, suggestion,
+				))
+			} else {
+				errs = append(errs, fmt.Errorf("loader '%s' found unknown key '%s'", loader.Name(), key))
+			}
+		}
+	}
+
+	return errs
+}
+
+// closestKey returns the candidate with the smallest edit distance to the key, or an empty string when no candidate
+// is similar enough to be a likely match.
+func closestKey(key string, candidates []string) string {
+	closest := ""
+	maxDistance := max(2, len(key)/3)
+	closestDistance := maxDistance + 1
+
+	for _, candidate := range candidates {
+		if distance := editDistance(key, candidate); distance < closestDistance {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+
+	return closest
+}
+
+// editDistance calculates the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	aRunes, bRunes := []rune(a), []rune(b)
+	previous := make([]int, len(bRunes)+1)
+	current := make([]int, len(bRunes)+1)
+
+	for idx := range previous {
+		previous[idx] = idx
+	}
+
+	for i := 1; i <= len(aRunes); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(bRunes); j++ {
+			cost := 1
+			if aRunes[i-1] == bRunes[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(bRunes)]
+}
@@ -0,0 +1,73 @@
+package bconf_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestAppConfigStrictMode(t *testing.T) {
+	t.Setenv("BCONF_STRICT_TEST_SERVER_PORT", "8080")
+	t.Setenv("BCONF_STRICT_TEST_SERVER_HOTS", "localhost")
+
+	args := os.Args
+	os.Args = []string{"testapp", "--sever_port", "8080", "--help_me"}
+
+	t.Cleanup(func() { os.Args = args })
+
+	newAppConfig := func() *bconf.AppConfig {
+		appConfig := bconf.NewAppConfig(
+			"testapp",
+			"testapp description",
+			bconf.WithEnvironmentLoader("bconf_strict_test"),
+			bconf.WithFlagLoader(),
+			bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_01.json"),
+		)
+
+		appConfig.AddFieldSet(bconf.FSB("server").Fields(
+			bconf.FB("port", bconf.Int).C(),
+			bconf.FB("host", bconf.String).C(),
+		).C())
+
+		return appConfig
+	}
+
+	if errs := newAppConfig().Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config without strict mode: %v", errs)
+	}
+
+	errs := newAppConfig().Load(bconf.EnableStrictMode())
+
+	expectedErrors := []string{
+		"unknown key 'BCONF_STRICT_TEST_SERVER_HOTS' (did you mean 'BCONF_STRICT_TEST_SERVER_HOST'?)",
+		"unknown key 'sever_port' (did you mean 'server_port'?)",
+		"unknown key 'help_me'",
+		"unknown key 'app.internal_ports'",
+		"unknown key 'app.port'",
+		"unknown key 'app.secret'",
+		"unknown key 'app.some_key'",
+		"unknown key 'log.level'",
+		"unknown key 'app_id' (did you mean 'app.id'?)",
+		"unknown key 'strange_key'",
+	}
+
+	if len(errs) != len(expectedErrors) {
+		t.Fatalf("unexpected strict mode errors (expected %d): %v", len(expectedErrors), errs)
+	}
+
+	for _, expectedError := range expectedErrors {
+		found := false
+
+		for _, err := range errs {
+			if strings.Contains(err.Error(), expectedError) {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Errorf("expected strict mode error containing \"%s\", found: %v", expectedError, errs)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package bconf
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// Transformer normalizes a parsed field value, returning the transformed value. Transformers are added to fields with
+// the FieldBuilder Transform method, and must return a value of the field type.
+type Transformer interface {
+	Transform(value any) (any, error)
+}
+
+// TransformerFunc adapts a function to a Transformer.
+type TransformerFunc func(value any) (any, error)
+
+// Transform calls the transformer function.
+func (f TransformerFunc) Transform(value any) (any, error) {
+	return f(value)
+}
+
+// StringTransformer is a Transformer applied to string values and string list elements. For other field-types, string
+// transformers are applied to raw string values (and raw list elements) before they are parsed to the field-type, e.g.
+// trimming white space from an int value, and parsed values are left unchanged.
+type StringTransformer func(value string) (string, error)
+
+// Transform applies the string transformer to string values and each element of string list values, leaving values of
+// other types unchanged.
+func (f StringTransformer) Transform(value any) (any, error) {
+	switch typedValue := value.(type) {
+	case string:
+		return f(typedValue)
+	case []string:
+		transformedValues := make([]string, len(typedValue))
+
+		for idx, element := range typedValue {
+			transformedValue, err := f(element)
+			if err != nil {
+				return nil, err
+			}
+
+			transformedValues[idx] = transformedValue
+		}
+
+		return transformedValues, nil
+	default:
+		return value, nil
+	}
+}
+
+// TrimSpace removes leading and trailing white space from string values and string list elements.
+func TrimSpace() Transformer {
+	return StringTransformer(func(value string) (string, error) {
+		return strings.TrimSpace(value), nil
+	})
+}
+
+// ToLower converts string values and string list elements to lower case.
+func ToLower() Transformer {
+	return StringTransformer(func(value string) (string, error) {
+		return strings.ToLower(value), nil
+	})
+}
+
+// ExpandEnv replaces '$VAR' and '${VAR}' references in string values and string list elements with environment
+// variable values.
+func ExpandEnv() Transformer {
+	return StringTransformer(func(value string) (string, error) {
+		return os.ExpandEnv(value), nil
+	})
+}
+
+// ExpandHome replaces a leading '~' in string values and string list elements with the user home directory.
+func ExpandHome() Transformer {
+	return StringTransformer(func(value string) (string, error) {
+		if value != "~" && !strings.HasPrefix(value, "~/") {
+			return value, nil
+		}
+
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("problem finding user home directory: %w", err)
+		}
+
+		return filepath.Join(homeDir, strings.TrimPrefix(value, "~")), nil
+	})
+}
+
+// AbsPath converts non-empty string values and string list elements to absolute, cleaned file paths.
+func AbsPath() Transformer {
+	return StringTransformer(func(value string) (string, error) {
+		if value == "" {
+			return value, nil
+		}
+
+		absPath, err := filepath.Abs(value)
+		if err != nil {
+			return "", fmt.Errorf("problem finding absolute path: %w", err)
+		}
+
+		return absPath, nil
+	})
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// transformRawString applies the field string transformers to a raw string value of a non-string field-type before
+// it is parsed.
+func (f *Field) transformRawString(value string) (string, error) {
+	if f.Type == String || f.Type == Strings {
+		return value, nil
+	}
+
+	for _, transformer := range f.Transformers {
+		stringTransformer, isStringTransformer := transformer.(StringTransformer)
+		if !isStringTransformer {
+			continue
+		}
+
+		transformedValue, err := stringTransformer(value)
+		if err != nil {
+			return "", fmt.Errorf("value transform error: %w", err)
+		}
+
+		value = transformedValue
+	}
+
+	return value, nil
+}
+
+func (f *Field) transform(value any) (any, error) {
+	for _, transformer := range f.Transformers {
+		transformedValue, err := transformer.Transform(value)
+		if err != nil {
+			return nil, fmt.Errorf("value transform error: %w", err)
+		}
+
+		transformedType := reflect.TypeOf(transformedValue)
+		if transformedType == nil || transformedType.String() != f.Type {
+			return nil, fmt.Errorf("value transform error: expected '%s' value, found '%v'", f.Type, transformedType)
+		}
+
+		value = transformedValue
+	}
+
+	return value, nil
+}
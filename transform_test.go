@@ -0,0 +1,119 @@
+package bconf_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestFieldTransform(t *testing.T) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		t.Skipf("user home directory not available: %s", err)
+	}
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("unexpected error finding working directory: %s", err)
+	}
+
+	t.Setenv("BCONF_TRANSFORM_TEST_DIR", "data")
+	t.Setenv("BCONF_TRANSFORM_TEST_API_LOG_LEVEL", "  DEBUG ")
+	t.Setenv("BCONF_TRANSFORM_TEST_API_CACHE_DIR", "~/cache")
+	t.Setenv("BCONF_TRANSFORM_TEST_API_DATA_DIR", "${BCONF_TRANSFORM_TEST_DIR}")
+	t.Setenv("BCONF_TRANSFORM_TEST_API_TAGS", " One,TWO ")
+	t.Setenv("BCONF_TRANSFORM_TEST_API_PORT", " 8080")
+	t.Setenv("BCONF_TRANSFORM_TEST_API_PORTS", "8080, 8081 ")
+	t.Setenv("BCONF_TRANSFORM_TEST_API_TIMEOUT", "30s ")
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithEnvironmentLoader("bconf_transform_test"),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("log_level", bconf.String).Transform(bconf.TrimSpace(), bconf.ToLower()).
+			Enumeration("debug", "info").C(),
+		bconf.FB("cache_dir", bconf.String).Transform(bconf.ExpandHome()).C(),
+		bconf.FB("data_dir", bconf.String).Transform(bconf.ExpandEnv(), bconf.AbsPath()).C(),
+		bconf.FB("tags", bconf.Strings).Transform(bconf.TrimSpace(), bconf.ToLower()).C(),
+		bconf.FB("port", bconf.Int).Transform(bconf.TrimSpace()).C(),
+		bconf.FB("ports", bconf.Ints).Transform(bconf.TrimSpace()).C(),
+		bconf.FB("timeout", bconf.Duration).Transform(bconf.TrimSpace()).C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if logLevel, _ := appConfig.GetString("api", "log_level"); logLevel != "debug" {
+		t.Errorf("unexpected log level '%s', expected 'debug'", logLevel)
+	}
+
+	if cacheDir, _ := appConfig.GetString("api", "cache_dir"); cacheDir != filepath.Join(homeDir, "cache") {
+		t.Errorf("unexpected cache dir '%s', expected '%s'", cacheDir, filepath.Join(homeDir, "cache"))
+	}
+
+	if dataDir, _ := appConfig.GetString("api", "data_dir"); dataDir != filepath.Join(workingDir, "data") {
+		t.Errorf("unexpected data dir '%s', expected '%s'", dataDir, filepath.Join(workingDir, "data"))
+	}
+
+	if tags, _ := appConfig.GetStrings("api", "tags"); !slices.Equal(tags, []string{"one", "two"}) {
+		t.Errorf("unexpected tags '%v', expected '[one two]'", tags)
+	}
+
+	if port, _ := appConfig.GetInt("api", "port"); port != 8080 {
+		t.Errorf("unexpected port '%d', expected '8080'", port)
+	}
+
+	if ports, _ := appConfig.GetInts("api", "ports"); !slices.Equal(ports, []int{8080, 8081}) {
+		t.Errorf("unexpected ports '%v', expected '[8080 8081]'", ports)
+	}
+
+	if timeout, _ := appConfig.GetDuration("api", "timeout"); timeout != 30*time.Second {
+		t.Errorf("unexpected timeout '%s', expected '30s'", timeout)
+	}
+
+	if err := appConfig.SetField("api", "log_level", " INFO"); err != nil {
+		t.Errorf("unexpected error setting transformed field value: %s", err)
+	}
+
+	if logLevel, _ := appConfig.GetString("api", "log_level"); logLevel != "info" {
+		t.Errorf("unexpected log level '%s', expected 'info'", logLevel)
+	}
+
+	if err := appConfig.SetField("api", "port", 9090); err != nil {
+		t.Errorf("unexpected error setting non-string field value: %s", err)
+	}
+
+	if err := appConfig.SetFieldString("api", "port", " 9091 "); err != nil {
+		t.Errorf("unexpected error setting non-string field string value: %s", err)
+	}
+
+	if port, _ := appConfig.GetInt("api", "port"); port != 9091 {
+		t.Errorf("unexpected port '%d', expected '9091'", port)
+	}
+}
+
+func TestFieldTransformTypeError(t *testing.T) {
+	appConfig := bconf.NewAppConfig("testapp", "testapp description")
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("port", bconf.Int).Transform(bconf.TransformerFunc(func(_ any) (any, error) {
+			return "8080", nil
+		})).C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if err := appConfig.SetField("api", "port", 8080); err == nil {
+		t.Fatal("expected error setting field with transformer returning a different type")
+	}
+}
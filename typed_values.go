@@ -0,0 +1,189 @@
+package bconf
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// loaderValues returns the values found by a loader, using typed values from loaders implementing TypedLoader, where
+// nil typed values (e.g. JSON null) are treated as not found.
+func loaderValues(loader Loader, fieldSetKey string, fieldKeys []string) map[string]any {
+	if typedLoader, ok := loader.(TypedLoader); ok {
+		values := typedLoader.GetTypedMap(fieldSetKey, fieldKeys)
+
+		maps.DeleteFunc(values, func(_ string, value any) bool {
+			return value == nil
+		})
+
+		return values
+	}
+
+	stringValues := loader.GetMap(fieldSetKey, fieldKeys)
+	values := make(map[string]any, len(stringValues))
+
+	for key, value := range stringValues {
+		values[key] = value
+	}
+
+	return values
+}
+
+// convertLoaderValue converts a typed loader value to the field-type, checking it against the field enumeration and
+// validator.
+func (f *Field) convertLoaderValue(value any) (any, error) {
+	convertedValue, err := f.convertTypedValue(value)
+	if err != nil {
+		return nil, fmt.Errorf("problem converting value to field-type: %w", err)
+	}
+
+	return f.checkLoaderValue(convertedValue)
+}
+
+func (f *Field) convertTypedValue(value any) (any, error) {
+	if stringValue, isString := value.(string); isString {
+		return f.parseString(stringValue)
+	}
+
+	if !isListFieldType(f.Type) {
+		return convertTypedScalar(f.Type, value)
+	}
+
+	values := reflect.ValueOf(value)
+	if value == nil || values.Kind() != reflect.Slice && values.Kind() != reflect.Array {
+		values = reflect.ValueOf([]any{value})
+	}
+
+	elementType := f.Type[2:]
+	convertedValues := reflect.MakeSlice(reflect.TypeOf(emptyListValue(elementType)), 0, values.Len())
+
+	for idx := range values.Len() {
+		element := values.Index(idx).Interface()
+
+		if stringElement, isString := element.(string); isString {
+			transformedElement, err := f.transformRawString(stringElement)
+			if err != nil {
+				return nil, fmt.Errorf("list element %d: %w", idx, err)
+			}
+
+			element = transformedElement
+		}
+
+		convertedValue, err := convertTypedScalar(elementType, element)
+		if err != nil {
+			return nil, fmt.Errorf("list element %d: %w", idx, err)
+		}
+
+		convertedValues = reflect.Append(convertedValues, reflect.ValueOf(convertedValue))
+	}
+
+	return convertedValues.Interface(), nil
+}
+
+// convertTypedScalar converts a typed value to a non-list field-type.
+func convertTypedScalar(fieldType string, value any) (any, error) {
+	switch typedValue := value.(type) {
+	case string:
+		return parseScalarString(fieldType, typedValue)
+	case json.Number:
+		return parseScalarString(fieldType, typedValue.String())
+	case bool:
+		switch fieldType {
+		case Bool:
+			return typedValue, nil
+		case String:
+			return strconv.FormatBool(typedValue), nil
+		}
+	case float64:
+		switch fieldType {
+		case Int:
+			if intValue := int(typedValue); float64(intValue) == typedValue {
+				return intValue, nil
+			}
+
+			return nil, fmt.Errorf("number '%v' is not an integer", typedValue)
+		case Float:
+			return typedValue, nil
+		case String:
+			return strconv.FormatFloat(typedValue, 'f', -1, 64), nil
+		}
+	case int:
+		switch fieldType {
+		case Int:
+			return typedValue, nil
+		case Float:
+			return float64(typedValue), nil
+		case String:
+			return strconv.Itoa(typedValue), nil
+		}
+	case int64:
+		switch fieldType {
+		case Int:
+			return int(typedValue), nil
+		case Float:
+			return float64(typedValue), nil
+		case String:
+			return strconv.FormatInt(typedValue, 10), nil
+		}
+	case time.Time:
+		if fieldType == Time {
+			return typedValue, nil
+		}
+	case time.Duration:
+		if fieldType == Duration {
+			return typedValue, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot convert '%T' value to '%s'", value, fieldType)
+}
+
+// jsonTextValue returns JSON object values, and nested arrays of list values, as their JSON text, matching how they are
+// loaded from loader string values. Other values are returned unchanged.
+func jsonTextValue(value any, isElement bool) any {
+	switch typedValue := value.(type) {
+	case map[string]any:
+	case []any:
+		if isElement {
+			break
+		}
+
+		values := make([]any, len(typedValue))
+
+		for idx, element := range typedValue {
+			values[idx] = jsonTextValue(element, true)
+		}
+
+		return values
+	default:
+		return value
+	}
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	return string(valueBytes)
+}
+
+// emptyListValue returns an empty list of the provided element field-type.
+func emptyListValue(elementType string) any {
+	switch elementType {
+	case Bool:
+		return []bool{}
+	case Int:
+		return []int{}
+	case Float:
+		return []float64{}
+	case Time:
+		return []time.Time{}
+	case Duration:
+		return []time.Duration{}
+	default:
+		return []string{}
+	}
+}
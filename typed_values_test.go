@@ -0,0 +1,203 @@
+package bconf_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestJSONFileLoaderGetTypedMap(t *testing.T) {
+	loader := bconf.NewJSONFileLoaderWithAttributes(nil, "./fixtures/json_config_test_fixture_04.json")
+
+	values := loader.GetTypedMap("api", []string{"port", "debug", "tags", "missing"})
+
+	if len(values) != 3 {
+		t.Fatalf("unexpected typed map length '%d', expected '3': %v", len(values), values)
+	}
+
+	if values["port"] != float64(8080) || values["debug"] != true {
+		t.Errorf("unexpected typed map values: %v", values)
+	}
+
+	if tags, _ := values["tags"].([]any); len(tags) != 2 || tags[0] != "a,b" {
+		t.Errorf("unexpected typed map tags value: %v", values["tags"])
+	}
+}
+
+func TestAppConfigTypedLoaderValues(t *testing.T) {
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_04.json"),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("host", bconf.String).C(),
+		bconf.FB("port", bconf.Int).C(),
+		bconf.FB("debug", bconf.Bool).C(),
+		bconf.FB("tags", bconf.Strings).C(),
+		bconf.FB("ports", bconf.Ints).C(),
+		bconf.FB("timeouts", bconf.Durations).C(),
+		bconf.FB("started", bconf.Time).C(),
+		bconf.FB("version", bconf.String).C(),
+		bconf.FB("replicas", bconf.Ints).C(),
+		bconf.FB("ratio", bconf.Float).C(),
+		bconf.FB("ratios", bconf.Floats).C(),
+		bconf.FB("labels", bconf.String).C(),
+		bconf.FB("parent", bconf.String).Default("root").C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if port, _ := appConfig.GetInt("api", "port"); port != 8080 {
+		t.Errorf("unexpected port '%d', expected '8080'", port)
+	}
+
+	if debug, _ := appConfig.GetBool("api", "debug"); !debug {
+		t.Error("expected debug to be true")
+	}
+
+	if tags, _ := appConfig.GetStrings("api", "tags"); !slices.Equal(tags, []string{"a,b", `say "hi"`}) {
+		t.Errorf("unexpected tags %q", tags)
+	}
+
+	if ports, _ := appConfig.GetInts("api", "ports"); !slices.Equal(ports, []int{8081, 8082}) {
+		t.Errorf("unexpected ports %v", ports)
+	}
+
+	if timeouts, _ := appConfig.GetDurations("api", "timeouts"); !slices.Equal(
+		timeouts, []time.Duration{time.Second, 2 * time.Minute},
+	) {
+		t.Errorf("unexpected timeouts %v", timeouts)
+	}
+
+	if started, _ := appConfig.GetTime("api", "started"); !started.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("unexpected started time %v", started)
+	}
+
+	if version, _ := appConfig.GetString("api", "version"); version != "2.5" {
+		t.Errorf("unexpected version '%s', expected '2.5'", version)
+	}
+
+	if replicas, _ := appConfig.GetInts("api", "replicas"); !slices.Equal(replicas, []int{3}) {
+		t.Errorf("unexpected replicas %v, expected single element list", replicas)
+	}
+
+	ratio, _ := appConfig.FieldSets()[1].Field("ratio")
+	if ratio.Value != 0.75 {
+		t.Errorf("unexpected ratio '%v', expected '0.75'", ratio.Value)
+	}
+
+	if ratios, _ := appConfig.FieldSets()[1].Field("ratios"); !reflect.DeepEqual(ratios.Value, []float64{0.5, 2}) {
+		t.Errorf("unexpected ratios %v", ratios.Value)
+	}
+
+	if labels, _ := appConfig.GetString("api", "labels"); labels != `{"team":"core"}` {
+		t.Errorf("unexpected labels '%s', expected JSON object text", labels)
+	}
+
+	if parent, _ := appConfig.GetString("api", "parent"); parent != "root" {
+		t.Errorf("unexpected parent '%s', expected JSON null to leave the default value 'root'", parent)
+	}
+}
+
+func TestAppConfigTypedLoaderNullValues(t *testing.T) {
+	fallbackPath := filepath.Join(t.TempDir(), "fallback.json")
+	if err := os.WriteFile(fallbackPath, []byte(`{"api": {"parent": "fallback"}}`), 0o600); err != nil {
+		t.Fatalf("unexpected error writing fallback file: %s", err)
+	}
+
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_04.json", fallbackPath),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(bconf.FB("parent", bconf.String).Required().C()).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if parent, _ := appConfig.GetString("api", "parent"); parent != "fallback" {
+		t.Errorf("unexpected parent '%s', expected JSON null to leave the lower priority value 'fallback'", parent)
+	}
+
+	if values := bconf.NewJSONFileLoaderWithAttributes(
+		nil, "./fixtures/json_config_test_fixture_04.json",
+	).GetTypedMap("api", []string{"parent"}); len(values) > 0 {
+		t.Errorf("expected JSON null value to be treated as not found: %v", values)
+	}
+}
+
+func TestAppConfigTypedLoaderTransformValue(t *testing.T) {
+	appConfig := bconf.NewAppConfig(
+		"testapp",
+		"testapp description",
+		bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_04.json"),
+		bconf.WithHooks(bconf.Hooks{
+			TransformValue: func(_, _, _, value string) (string, error) {
+				encrypted, found := strings.CutPrefix(value, "enc:")
+				if !found {
+					return value, nil
+				}
+
+				decrypted := []rune(encrypted)
+				slices.Reverse(decrypted)
+
+				return string(decrypted), nil
+			},
+		}),
+	)
+
+	appConfig.AddFieldSet(bconf.FSB("api").Fields(
+		bconf.FB("token", bconf.String).Sensitive().C(),
+		bconf.FB("tokens", bconf.Strings).Sensitive().C(),
+		bconf.FB("port", bconf.Int).C(),
+	).C())
+
+	if errs := appConfig.Load(); len(errs) > 0 {
+		t.Fatalf("unexpected error(s) loading app config: %v", errs)
+	}
+
+	if token, _ := appConfig.GetString("api", "token"); token != "secret" {
+		t.Errorf("unexpected token '%s', expected transformed value 'secret'", token)
+	}
+
+	if tokens, _ := appConfig.GetStrings("api", "tokens"); !slices.Equal(tokens, []string{"one", "two"}) {
+		t.Errorf("unexpected tokens %v, expected transformed elements '[one two]'", tokens)
+	}
+
+	if port, _ := appConfig.GetInt("api", "port"); port != 8080 {
+		t.Errorf("unexpected port '%d', expected '8080'", port)
+	}
+}
+
+func TestAppConfigTypedLoaderValueErrors(t *testing.T) {
+	fieldSets := map[string]*bconf.FieldSet{
+		"non-integer number": bconf.FSB("invalid").Fields(bconf.FB("port", bconf.Int).C()).C(),
+		"mixed list":         bconf.FSB("invalid").Fields(bconf.FB("flags", bconf.Bools).C()).C(),
+	}
+
+	for name, fieldSet := range fieldSets {
+		appConfig := bconf.NewAppConfig(
+			"testapp",
+			"testapp description",
+			bconf.WithJSONFileLoader("./fixtures/json_config_test_fixture_04.json"),
+		)
+
+		appConfig.AddFieldSet(fieldSet)
+
+		if errs := appConfig.Load(); len(errs) < 1 {
+			t.Errorf("expected error loading %s value", name)
+		}
+	}
+}